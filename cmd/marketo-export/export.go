@@ -0,0 +1,383 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/eveld/terraform-provider-marketo/marketo"
+)
+
+// exporter collects the configuration of every asset below a folder. Assets
+// refer to each other through the references in refs, so that the output
+// does not contain IDs apart from the import commands.
+type exporter struct {
+	client *marketo.Client
+	names  names
+
+	// refs holds the expression for the ID of every exported folder and
+	// program, templates those of every exported or looked up template.
+	refs      map[marketo.FolderID]string
+	templates map[int]string
+
+	files   map[string]*bytes.Buffer
+	html    map[string]string
+	imports bytes.Buffer
+}
+
+func newExporter(client *marketo.Client) *exporter {
+	return &exporter{
+		client:    client,
+		names:     names{},
+		refs:      map[marketo.FolderID]string{},
+		templates: map[int]string{},
+		files:     map[string]*bytes.Buffer{},
+		html:      map[string]string{},
+	}
+}
+
+func (e *exporter) file(name string) *bytes.Buffer {
+	if e.files[name] == nil {
+		e.files[name] = &bytes.Buffer{}
+	}
+	return e.files[name]
+}
+
+func (e *exporter) importCommand(address string, id int) {
+	fmt.Fprintf(&e.imports, "terraform import '%s' %d\n", address, id)
+}
+
+// parent returns the folder or program attribute that points at parent.
+func (e *exporter) parent(parent marketo.FolderID) (attribute, error) {
+	ref, ok := e.refs[parent]
+	if !ok {
+		return attribute{}, fmt.Errorf("%s %d was not exported", parent.Type, parent.ID)
+	}
+
+	if parent.Type == "Program" {
+		return attribute{"program", ref}, nil
+	}
+	return attribute{"folder", ref}, nil
+}
+
+// export walks the folder at path and everything below it, up to depth
+// levels deep. The folder itself is looked up rather than managed.
+func (e *exporter) export(ctx context.Context, path string, depth int) error {
+	root, err := e.client.GetFolderByPath(ctx, path, "")
+	if err != nil {
+		return err
+	}
+
+	e.refs[root.FolderID] = "data.marketo_folder.root.id"
+	block(e.file("main.tf"), "data", "marketo_folder", "root", []attribute{
+		{"path", str(path)},
+	})
+
+	folders, err := e.client.ListFolders(ctx, root.FolderID, depth, root.Workspace)
+	if err != nil {
+		return err
+	}
+
+	// Names are handed out first, as folders can be listed before their
+	// parents.
+	addresses := map[marketo.FolderID]string{}
+	for _, folder := range folders {
+		typ := "marketo_folder"
+		if folder.FolderID.Type == "Program" {
+			typ = "marketo_program"
+		}
+		addresses[folder.FolderID] = typ + "." + e.names.next(typ, folder.Name)
+		e.refs[folder.FolderID] = addresses[folder.FolderID] + ".id"
+	}
+
+	for _, folder := range folders {
+		if folder.FolderID.Type == "Program" {
+			err = e.exportProgram(ctx, folder, addresses[folder.FolderID])
+		} else {
+			err = e.exportFolder(folder, addresses[folder.FolderID])
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	containers := []marketo.FolderID{root.FolderID}
+	for _, folder := range folders {
+		containers = append(containers, folder.FolderID)
+	}
+
+	err = e.exportEmailTemplates(ctx, containers)
+	if err != nil {
+		return err
+	}
+
+	for _, container := range containers {
+		err = e.exportEmails(ctx, container)
+		if err != nil {
+			return err
+		}
+
+		err = e.exportSmartLists(ctx, container)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func splitAddress(address string) (string, string) {
+	parts := strings.SplitN(address, ".", 2)
+	return parts[0], parts[1]
+}
+
+func (e *exporter) exportFolder(folder marketo.Folder, address string) error {
+	parent, err := e.parent(folder.Parent)
+	if err != nil {
+		return err
+	}
+
+	attrs := []attribute{{"name", str(folder.Name)}}
+	if folder.Description != "" {
+		attrs = append(attrs, attribute{"description", str(folder.Description)})
+	}
+	attrs = append(attrs, parent)
+
+	typ, name := splitAddress(address)
+	block(e.file("folders.tf"), "resource", typ, name, attrs)
+	e.importCommand(address, folder.ID)
+	return nil
+}
+
+func (e *exporter) exportProgram(ctx context.Context, folder marketo.Folder, address string) error {
+	program, err := e.client.GetProgram(ctx, strconv.Itoa(folder.FolderID.ID))
+	if err != nil {
+		return err
+	}
+
+	parent, err := e.parent(program.Folder)
+	if err != nil {
+		return err
+	}
+
+	attrs := []attribute{{"name", str(program.Name)}}
+	if program.Description != "" {
+		attrs = append(attrs, attribute{"description", str(program.Description)})
+	}
+	attrs = append(attrs,
+		attribute{"type", str(program.Type)},
+		attribute{"channel", str(program.Channel)},
+		parent,
+	)
+	if len(program.Tags) > 0 {
+		tags := map[string]string{}
+		for _, tag := range program.Tags {
+			tags[tag.Type] = str(tag.Value)
+		}
+		attrs = append(attrs, attribute{"tags", object(tags)})
+	}
+
+	typ, name := splitAddress(address)
+	block(e.file("programs.tf"), "resource", typ, name, attrs)
+	e.importCommand(address, program.ID)
+	return nil
+}
+
+// exportEmailTemplates exports the templates in any of the containers. Their
+// HTML is written to a file of its own next to the configuration.
+func (e *exporter) exportEmailTemplates(ctx context.Context, containers []marketo.FolderID) error {
+	emailTemplates, err := e.client.ListEmailTemplates(ctx, marketo.ListFilter{})
+	if err != nil {
+		return err
+	}
+
+	exported := map[marketo.FolderID]bool{}
+	for _, container := range containers {
+		exported[container] = true
+	}
+
+	for _, emailTemplate := range emailTemplates {
+		if !exported[emailTemplate.Folder] {
+			continue
+		}
+
+		content, err := e.client.GetEmailTemplateContent(ctx, strconv.Itoa(emailTemplate.ID))
+		if err != nil {
+			return err
+		}
+
+		parent, err := e.parent(emailTemplate.Folder)
+		if err != nil {
+			return err
+		}
+
+		name := e.names.next("marketo_email_template", emailTemplate.Name)
+		filename := filepath.Join("templates", name+".html")
+		e.html[filename] = content
+
+		attrs := []attribute{{"name", str(emailTemplate.Name)}}
+		if emailTemplate.Description != "" {
+			attrs = append(attrs, attribute{"description", str(emailTemplate.Description)})
+		}
+		attrs = append(attrs,
+			parent,
+			attribute{"content", `file("${path.module}/` + filepath.ToSlash(filename) + `")`},
+		)
+
+		block(e.file("email_templates.tf"), "resource", "marketo_email_template", name, attrs)
+		e.importCommand("marketo_email_template."+name, emailTemplate.ID)
+		e.templates[emailTemplate.ID] = "marketo_email_template." + name + ".id"
+	}
+
+	return nil
+}
+
+// template returns the reference to the template with the given ID, which
+// is looked up by name when it lives outside of the exported folders.
+func (e *exporter) template(ctx context.Context, id int) (string, error) {
+	if ref, ok := e.templates[id]; ok {
+		return ref, nil
+	}
+
+	emailTemplate, err := e.client.GetEmailTemplate(ctx, strconv.Itoa(id))
+	if err != nil {
+		return "", err
+	}
+
+	name := e.names.next("data.marketo_email_template", emailTemplate.Name)
+	block(e.file("email_templates.tf"), "data", "marketo_email_template", name, []attribute{
+		{"name", str(emailTemplate.Name)},
+	})
+
+	e.templates[id] = "data.marketo_email_template." + name + ".id"
+	return e.templates[id], nil
+}
+
+func (e *exporter) exportEmails(ctx context.Context, container marketo.FolderID) error {
+	emails, err := e.client.ListEmails(ctx, marketo.ListFilter{Folder: &container})
+	if err != nil {
+		return err
+	}
+
+	for _, email := range emails {
+		emailID := strconv.Itoa(email.ID)
+		sections, err := e.client.GetEmailContent(ctx, emailID)
+		if err != nil {
+			return err
+		}
+
+		parent, err := e.parent(email.Folder)
+		if err != nil {
+			return err
+		}
+
+		template, err := e.template(ctx, email.Template)
+		if err != nil {
+			return err
+		}
+
+		attrs := []attribute{{"name", str(email.Name)}}
+		if email.Description != "" {
+			attrs = append(attrs, attribute{"description", str(email.Description)})
+		}
+		attrs = append(attrs,
+			parent,
+			attribute{"from_email", str(email.FromEmail.Value)},
+			attribute{"from_name", str(email.FromName.Value)},
+			attribute{"reply_to", str(email.ReplyEmail.Value)},
+			attribute{"subject", str(email.Subject.Value)},
+			attribute{"template", template},
+		)
+		if email.Operational {
+			attrs = append(attrs, attribute{"operational", "true"})
+		}
+		if email.TextOnly {
+			attrs = append(attrs, attribute{"text_only", "true"})
+		}
+		if len(sections) > 0 {
+			attrs = append(attrs, attribute{"content", emailContent(sections)})
+		}
+
+		name := e.names.next("marketo_email", email.Name)
+		block(e.file("emails.tf"), "resource", "marketo_email", name, attrs)
+		e.importCommand("marketo_email."+name, email.ID)
+	}
+
+	return nil
+}
+
+func emailContent(sections []marketo.EmailSection) string {
+	var buf bytes.Buffer
+	buf.WriteString("[\n")
+	for _, section := range sections {
+		key := "text"
+		switch section.Type {
+		case "DynamicContent":
+			key = "dynamic_content"
+		case "Snippet":
+			key = "snippet"
+		}
+		fmt.Fprintf(&buf, "    {\n      section = %s\n      %s = %s\n    },\n", str(section.Section), key, str(section.Value))
+	}
+	buf.WriteString("  ]")
+	return buf.String()
+}
+
+// exportSmartLists exports the smart lists in container. Their rules cannot
+// be read through the API, so source is left out: import makes each one its
+// own source.
+func (e *exporter) exportSmartLists(ctx context.Context, container marketo.FolderID) error {
+	smartLists, err := e.client.ListSmartLists(ctx, marketo.ListFilter{Folder: &container})
+	if err != nil {
+		return err
+	}
+
+	for _, smartList := range smartLists {
+		parent, err := e.parent(smartList.Folder)
+		if err != nil {
+			return err
+		}
+
+		attrs := []attribute{{"name", str(smartList.Name)}}
+		if smartList.Description != "" {
+			attrs = append(attrs, attribute{"description", str(smartList.Description)})
+		}
+		attrs = append(attrs, parent)
+
+		name := e.names.next("marketo_smart_list", smartList.Name)
+		block(e.file("smart_lists.tf"), "resource", "marketo_smart_list", name, attrs)
+		e.importCommand("marketo_smart_list."+name, smartList.ID)
+	}
+
+	return nil
+}
+
+// write stores the configuration, the template HTML and an imports.sh script
+// with the import commands in dir.
+func (e *exporter) write(dir string) error {
+	err := os.MkdirAll(filepath.Join(dir, "templates"), 0755)
+	if err != nil {
+		return err
+	}
+
+	for name, buf := range e.files {
+		err = os.WriteFile(filepath.Join(dir, name), buf.Bytes(), 0644)
+		if err != nil {
+			return err
+		}
+	}
+
+	for name, content := range e.html {
+		err = os.WriteFile(filepath.Join(dir, name), []byte(content), 0644)
+		if err != nil {
+			return err
+		}
+	}
+
+	script := "#!/bin/sh\nset -e\n\n" + e.imports.String()
+	return os.WriteFile(filepath.Join(dir, "imports.sh"), []byte(script), 0755)
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// attribute is a single argument of a block. Value is an HCL expression, use
+// str for literal strings.
+type attribute struct {
+	name  string
+	value string
+}
+
+// block writes a resource or data block with the given attributes, one per
+// line in the order given.
+func block(buf *bytes.Buffer, kind string, typ string, name string, attrs []attribute) {
+	fmt.Fprintf(buf, "%s %q %q {\n", kind, typ, name)
+	for _, attr := range attrs {
+		fmt.Fprintf(buf, "  %s = %s\n", attr.name, attr.value)
+	}
+	buf.WriteString("}\n\n")
+}
+
+// str quotes s as an HCL string, escaping template sequences so they are not
+// interpolated.
+func str(s string) string {
+	quoted := strconv.Quote(s)
+	quoted = strings.ReplaceAll(quoted, "${", "$${")
+	return strings.ReplaceAll(quoted, "%{", "%%{")
+}
+
+// object writes a map of strings as an HCL object with sorted keys.
+func object(values map[string]string) string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	parts := make([]string, 0, len(keys))
+	for _, key := range keys {
+		parts = append(parts, str(key)+" = "+values[key])
+	}
+	return "{ " + strings.Join(parts, ", ") + " }"
+}
+
+var invalidName = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// names hands out unique Terraform resource names per resource type.
+type names map[string]map[string]bool
+
+func (n names) next(typ string, name string) string {
+	base := strings.Trim(invalidName.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if base == "" || (base[0] >= '0' && base[0] <= '9') {
+		base = "r_" + base
+	}
+
+	if n[typ] == nil {
+		n[typ] = map[string]bool{}
+	}
+
+	result := base
+	for i := 2; n[typ][result]; i++ {
+		result = base + "_" + strconv.Itoa(i)
+	}
+	n[typ][result] = true
+	return result
+}
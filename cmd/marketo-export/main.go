@@ -0,0 +1,65 @@
+// Command marketo-export generates Terraform configuration for the folders,
+// programs, emails, email templates and smart lists below a folder of an
+// existing Marketo instance, together with the import commands that bring
+// them under management.
+//
+//	marketo-export -path "Marketing Activities/Events" -out events
+//
+// Credentials are read from the same MARKETO_ENDPOINT or MARKETO_MUNCHKIN_ID,
+// MARKETO_ID and MARKETO_SECRET environment variables the provider uses.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/eveld/terraform-provider-marketo/marketo"
+)
+
+func main() {
+	endpoint := flag.String("endpoint", os.Getenv("MARKETO_ENDPOINT"), "REST API endpoint, for example https://123-ABC-456.mktorest.com/rest")
+	munchkinID := flag.String("munchkin-id", os.Getenv("MARKETO_MUNCHKIN_ID"), "Munchkin ID of the instance, instead of -endpoint")
+	id := flag.String("id", os.Getenv("MARKETO_ID"), "client ID of the API user")
+	secret := flag.String("secret", os.Getenv("MARKETO_SECRET"), "client secret of the API user")
+	workspace := flag.String("workspace", "", "workspace to export from, defaults to the Default workspace")
+	path := flag.String("path", "", "path of the folder to export, for example Marketing Activities/Events")
+	depth := flag.Int("depth", 10, "number of folder levels below path to export")
+	out := flag.String("out", "generated", "directory to write the configuration to")
+	flag.Parse()
+
+	if *endpoint == "" && *munchkinID != "" {
+		var err error
+		*endpoint, err = marketo.MunchkinEndpoint(*munchkinID)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(2)
+		}
+	}
+
+	if *endpoint == "" || *id == "" || *secret == "" || *path == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	client, err := marketo.NewClient(*endpoint, *id, *secret)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "unable to create marketo client:", err)
+		os.Exit(1)
+	}
+	client.Workspace = *workspace
+
+	e := newExporter(client)
+	err = e.export(context.Background(), *path, *depth)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "unable to export", *path+":", err)
+		os.Exit(1)
+	}
+
+	err = e.write(*out)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "unable to write configuration:", err)
+		os.Exit(1)
+	}
+}
@@ -4,6 +4,7 @@
     <title>${title}</title>
   </head>
   <body>
+    <img src="${logo}" alt="HashiTalks">
     ${body}
   </body>
 </html>
\ No newline at end of file
@@ -8,15 +8,68 @@ terraform {
 }
 
 provider "marketo" {
+	# mutually exclusive, endpoints are derived from the munchkin id
 	endpoint = "test"
+	# munchkin_id = "123-ABC-456"
 	id = "test"
 	secret = "test"
+	# settings that are not configured here or in the environment are read
+	# from this profile of ~/.marketo/credentials
+	# profile = "sandbox"
+
+	# folders and programs without a parent are created in the root of this
+	# workspace
+	workspace = "EMEA"
+
+	# report edits made in Marketo to emails, templates, snippets and
+	# segmentations as warnings instead of reverting them
+	drift_policy = "warn"
+
+	# bounds every single request, resources bound whole operations with a
+	# timeouts block
+	request_timeout = "1m"
+
+	# report missing permissions of the API user before anything is applied
+	verify_credentials = true
+}
+
+data "marketo_workspace" "emea" {}
+
+data "marketo_folder" "events" {
+	path = "Marketing Activities/Events"
+}
+
+data "marketo_program" "master" {
+	name = "HashiTalks: Master"
+	folder = data.marketo_folder.events.id
+}
+
+data "marketo_email" "invite" {
+	name = "Invite"
+	program = data.marketo_program.master.id
+}
+
+data "marketo_email_template" "base" {
+	name = "Base"
+}
+
+data "marketo_smart_campaign" "send_invite" {
+	name = "Send Invite"
+	program = data.marketo_program.master.id
 }
 
 data "marketo_channel" "channel" {
-	name = "channel"
+	name = "Webinar"
+}
+
+data "marketo_channel" "email" {
+	name = "Email Send"
 }
 
+data "marketo_tag_types" "all" {}
+
+data "marketo_channels" "all" {}
+
 data "marketo_smart_list" "source" {
 	name = "source"
 }
@@ -25,16 +78,18 @@ resource "marketo_folder" "folder" {
 	name = "HashiTalks"
 	description = ""
 
-	# mutually exclusive
+	# mutually exclusive, defaults to the root of the workspace
 	# program = marketo_program.program.id
-	# folder = marketo_folder.folder.id 
+	folder = data.marketo_folder.events.id
+
+	# workspace = data.marketo_workspace.emea.name
 }
 
 resource "marketo_program" "program" {
 	name = "HashiTalks: Region"
 	description = "HashiTalks: Region"
 
-	type = "default"
+	type = "Event with Webinar"
 
 	# cost {
 	# 	amount = 1
@@ -46,12 +101,21 @@ resource "marketo_program" "program" {
 	# program = marketo_program.program.id
 	folder = marketo_folder.folder.id 
 
-	channel = data.marketo_channel.channel.id
+	channel = data.marketo_channel.channel.name
 
-	# tag {
-	# 	type = ""
-	# 	value = ""
-	# }
+	event = {
+		start_at = "2022-02-17T09:00:00Z"
+		end_at = "2022-02-18T18:00:00Z"
+
+		webinar = {
+			provider = "Zoom"
+			event_id = "123456789"
+		}
+	}
+
+	tags = {
+		"Region" = "EMEA"
+	}
 }
 
 resource "marketo_email_template" "template" {
@@ -65,6 +129,7 @@ resource "marketo_email_template" "template" {
 	content = templatefile("${path.module}/files/template.html.tpl", {
 		title = "title"
 		body = "body"
+		logo = marketo_file.logo.url
 	})
 }
 
@@ -120,4 +185,166 @@ resource "marketo_smart_list" "list" {
 	# mutually exclusive
 	program = marketo_program.program.id
 	# folder = marketo_folder.folder.id
-}
\ No newline at end of file
+}
+resource "marketo_snippet" "footer" {
+	name = "footer"
+	description = "Shared footer for all HashiTalks emails"
+
+	folder = marketo_folder.folder.id
+
+	html = "<p>HashiCorp, Inc.</p>"
+	text = "HashiCorp, Inc."
+
+	approved = true
+}
+
+resource "marketo_program_tokens" "tokens" {
+	program = marketo_program.program.id
+
+	tokens = [
+		{
+			name = "event_date"
+			type = "date"
+			value = "2022-02-17"
+		},
+		{
+			name = "webinar_url"
+			type = "text"
+			value = "https://hashitalks.com"
+		},
+	]
+}
+
+resource "marketo_static_list" "testers" {
+	name = "Internal testers"
+	description = "Seed list for internal testers"
+
+	program = marketo_program.program.id
+}
+
+resource "marketo_static_list_membership" "testers" {
+	list = marketo_static_list.testers.id
+
+	leads = [1001, 1002, 1003]
+}
+
+resource "marketo_segmentation" "region" {
+	name = "Region"
+	description = "Segments leads by HashiTalks region"
+
+	folder = marketo_folder.folder.id
+
+	approved = true
+}
+
+resource "marketo_file" "logo" {
+	name = "hashitalks-logo.png"
+	description = "HashiTalks logo"
+
+	folder = marketo_folder.folder.id
+
+	source = "${path.module}/files/logo.png"
+}
+
+resource "marketo_program" "region" {
+	name = "HashiTalks: Europe"
+	description = "HashiTalks: Europe"
+
+	clone_from = marketo_program.program.id
+	folder = marketo_folder.folder.id
+
+	# clones of large programs take a while
+	timeouts = {
+		create = "30m"
+	}
+}
+
+output "region_invite_email" {
+	value = marketo_program.region.assets["emails"]["cfp open"]
+}
+
+resource "marketo_program" "newsletter" {
+	name = "HashiTalks: Newsletter"
+	description = "Monthly HashiTalks newsletter"
+
+	type = "Email"
+	folder = marketo_folder.folder.id
+	channel = data.marketo_channel.email.name
+
+	email_program = {
+		send_at = "2022-02-01T09:00:00Z"
+		recipient_time_zone = true
+		head_start = true
+
+		ab_test = {
+			type = "subject"
+			sample_size = 20
+			winner_criteria = "opens"
+			winner_send_at = "2022-02-02T09:00:00Z"
+		}
+
+		approved = true
+	}
+}
+
+resource "marketo_program" "nurture" {
+	name = "HashiTalks: Nurture"
+	description = "Nurture for HashiTalks attendees"
+
+	type = "Engagement"
+	folder = marketo_folder.folder.id
+	channel = "Nurture"
+}
+
+resource "marketo_engagement_stream" "attendees" {
+	program = marketo_program.nurture.id
+	name = "Attendees"
+
+	cadence = {
+		day_of_week = "Tuesday"
+		time = "10:00"
+		recurrence = "weekly"
+	}
+}
+
+resource "marketo_engagement_stream_content" "attendees" {
+	program = marketo_program.nurture.id
+	stream = marketo_engagement_stream.attendees.id
+
+	content = [
+		{
+			type = "email"
+			id = marketo_email.email.id
+			active = true
+		},
+		{
+			type = "program"
+			id = marketo_program.region.id
+			active = false
+		},
+	]
+}
+
+data "marketo_programs" "regional" {
+	folder = data.marketo_folder.events.id
+	tag_type = "Region"
+	tag_value = "EMEA"
+	updated_after = "2022-01-01T00:00:00Z"
+}
+
+data "marketo_emails" "approved" {
+	program = data.marketo_program.master.id
+	status = "approved"
+}
+
+data "marketo_smart_campaigns" "active" {
+	program = data.marketo_program.master.id
+	status = "active"
+}
+
+resource "marketo_smart_campaign" "reminder" {
+	for_each = { for p in data.marketo_programs.regional.programs : p.name => p.id }
+
+	name = "Send Reminder"
+	program = each.value
+}
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
@@ -25,21 +26,29 @@ func (r dataSourceChannelType) GetSchema(_ context.Context) (tfsdk.Schema, diag.
 				Type:     types.StringType,
 				Required: true,
 			},
+			"applicable_program_type": {
+				Type:     types.StringType,
+				Computed: true,
+			},
 		},
 	}, nil
 }
 
 func (r dataSourceChannelType) NewDataSource(_ context.Context, p tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
 	return dataSourceChannel{
-		p: *(p.(*provider)),
+		p: p.(*provider),
 	}, nil
 }
 
 type dataSourceChannel struct {
-	p provider
+	p *provider
 }
 
 func (r dataSourceChannel) Read(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
+	if !r.p.ready(&resp.Diagnostics) {
+		return
+	}
+
 	var data Channel
 	diags := req.Config.Get(ctx, &data)
 	resp.Diagnostics.Append(diags...)
@@ -47,7 +56,18 @@ func (r dataSourceChannel) Read(ctx context.Context, req tfsdk.ReadDataSourceReq
 		return
 	}
 
-	data.ID = types.String{Value: "x"}
+	channel, err := r.p.client().GetChannelByName(ctx, data.Name.Value)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading channel",
+			"Could not find channel "+data.Name.Value+": "+err.Error(),
+		)
+		return
+	}
+
+	data.ID = types.String{Value: strconv.Itoa(channel.ID)}
+	data.LastUpdated = timestamp(channel.UpdatedAt)
+	data.ApplicableProgramType = types.String{Value: channel.ApplicableProgramType}
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 }
@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type dataSourceChannelsType struct{}
+
+func (r dataSourceChannelsType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"channels": {
+				Computed: true,
+				Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
+					"id": {
+						Type:     types.StringType,
+						Computed: true,
+					},
+					"last_updated": {
+						Type:     types.StringType,
+						Computed: true,
+					},
+					"name": {
+						Type:     types.StringType,
+						Computed: true,
+					},
+					"applicable_program_type": {
+						Type:     types.StringType,
+						Computed: true,
+					},
+				}, tfsdk.ListNestedAttributesOptions{}),
+			},
+		},
+	}, nil
+}
+
+func (r dataSourceChannelsType) NewDataSource(_ context.Context, p tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	return dataSourceChannels{
+		p: p.(*provider),
+	}, nil
+}
+
+type dataSourceChannels struct {
+	p *provider
+}
+
+func (r dataSourceChannels) Read(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
+	if !r.p.ready(&resp.Diagnostics) {
+		return
+	}
+
+	var data Channels
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channels, err := r.p.client().ListChannels(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading channels",
+			"Could not list channels: "+err.Error(),
+		)
+		return
+	}
+
+	data.ID = types.String{Value: "channels"}
+	data.Channels = make([]Channel, 0, len(channels))
+	for _, channel := range channels {
+		data.Channels = append(data.Channels, Channel{
+			ID:                    types.String{Value: strconv.Itoa(channel.ID)},
+			LastUpdated:           timestamp(channel.UpdatedAt),
+			Name:                  types.String{Value: channel.Name},
+			ApplicableProgramType: types.String{Value: channel.ApplicableProgramType},
+		})
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
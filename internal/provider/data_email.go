@@ -0,0 +1,192 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/eveld/terraform-provider-marketo/marketo"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type dataSourceEmailType struct{}
+
+func (r dataSourceEmailType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"last_updated": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"name": {
+				Type:     types.StringType,
+				Required: true,
+			},
+			"description": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"folder": {
+				Type:        types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Description: "Only find the email in this folder.",
+			},
+			"program": {
+				Type:        types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Description: "Only find the email in this program.",
+			},
+			"from_email": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"from_name": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"reply_to": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"operational": {
+				Type:     types.BoolType,
+				Computed: true,
+			},
+			"text_only": {
+				Type:     types.BoolType,
+				Computed: true,
+			},
+			"subject": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"template": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"content": {
+				Computed: true,
+				Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
+					"section": {
+						Type:     types.StringType,
+						Computed: true,
+					},
+					"text": {
+						Type:     types.StringType,
+						Computed: true,
+					},
+					"dynamic_content": {
+						Type:     types.StringType,
+						Computed: true,
+					},
+					"snippet": {
+						Type:     types.StringType,
+						Computed: true,
+					},
+				}, tfsdk.ListNestedAttributesOptions{}),
+			},
+		},
+	}, nil
+}
+
+func (r dataSourceEmailType) NewDataSource(_ context.Context, p tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	return dataSourceEmail{
+		p: p.(*provider),
+	}, nil
+}
+
+type dataSourceEmail struct {
+	p *provider
+}
+
+// emailContent is the inverse of emailSections and sets the attribute that
+// matches the type of each section.
+func emailContent(sections []marketo.EmailSection) []EmailContent {
+	content := []EmailContent{}
+	for _, section := range sections {
+		c := EmailContent{
+			Section:        types.String{Value: section.Section},
+			Text:           types.String{Null: true},
+			DynamicContent: types.String{Null: true},
+			Snippet:        types.String{Null: true},
+		}
+		switch section.Type {
+		case "DynamicContent":
+			c.DynamicContent = types.String{Value: section.Value}
+		case "Snippet":
+			c.Snippet = types.String{Value: section.Value}
+		default:
+			c.Text = types.String{Value: section.Value}
+		}
+		content = append(content, c)
+	}
+	return content
+}
+
+func (r dataSourceEmail) Read(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
+	if !r.p.ready(&resp.Diagnostics) {
+		return
+	}
+
+	var data EmailData
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	folder, err := scopeFolder(data.Folder, data.Program)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid folder",
+			err.Error(),
+		)
+		return
+	}
+
+	email, err := r.p.client().GetEmailByName(ctx, data.Name.Value, folder)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading email",
+			"Could not find email "+strconv.Quote(data.Name.Value)+": "+err.Error(),
+		)
+		return
+	}
+
+	emailID := strconv.Itoa(email.ID)
+	sections, err := r.p.client().GetEmailContent(ctx, emailID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading email",
+			"Could not read content of email with ID "+emailID+": "+err.Error(),
+		)
+		return
+	}
+
+	data.ID = types.String{Value: emailID}
+	data.CreatedAt = timestamp(email.CreatedAt)
+	data.LastUpdated = timestamp(email.UpdatedAt)
+	data.Description = types.String{Value: email.Description}
+	setParent(email.Folder, &data.Folder, &data.Program)
+	data.FromEmail = types.String{Value: email.FromEmail.Value}
+	data.FromName = types.String{Value: email.FromName.Value}
+	data.ReplyTo = types.String{Value: email.ReplyEmail.Value}
+	data.Operational = types.Bool{Value: email.Operational}
+	data.TextOnly = types.Bool{Value: email.TextOnly}
+	data.Subject = types.String{Value: email.Subject.Value}
+	data.Template = types.String{Value: strconv.Itoa(email.Template)}
+	data.Content = emailContent(sections)
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
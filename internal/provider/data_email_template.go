@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type dataSourceEmailTemplateType struct{}
+
+func (r dataSourceEmailTemplateType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"last_updated": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"name": {
+				Type:     types.StringType,
+				Required: true,
+			},
+			"description": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"folder": {
+				Type:        types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Description: "Only find the template in this folder.",
+			},
+			"program": {
+				Type:     types.StringType,
+				Optional: true,
+				Computed: true,
+			},
+			"content": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+		},
+	}, nil
+}
+
+func (r dataSourceEmailTemplateType) NewDataSource(_ context.Context, p tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	return dataSourceEmailTemplate{
+		p: p.(*provider),
+	}, nil
+}
+
+type dataSourceEmailTemplate struct {
+	p *provider
+}
+
+func (r dataSourceEmailTemplate) Read(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
+	if !r.p.ready(&resp.Diagnostics) {
+		return
+	}
+
+	var data EmailTemplateData
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	folder, err := scopeFolder(data.Folder, data.Program)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid folder",
+			err.Error(),
+		)
+		return
+	}
+
+	emailTemplate, err := r.p.client().GetEmailTemplateByName(ctx, data.Name.Value, folder)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading email template",
+			"Could not find email template "+strconv.Quote(data.Name.Value)+": "+err.Error(),
+		)
+		return
+	}
+
+	emailTemplateID := strconv.Itoa(emailTemplate.ID)
+	content, err := r.p.client().GetEmailTemplateContent(ctx, emailTemplateID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading email template",
+			"Could not read content of email template with ID "+emailTemplateID+": "+err.Error(),
+		)
+		return
+	}
+
+	data.ID = types.String{Value: emailTemplateID}
+	data.CreatedAt = timestamp(emailTemplate.CreatedAt)
+	data.LastUpdated = timestamp(emailTemplate.UpdatedAt)
+	data.Description = types.String{Value: emailTemplate.Description}
+	setParent(emailTemplate.Folder, &data.Folder, &data.Program)
+	data.Content = types.String{Value: content}
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
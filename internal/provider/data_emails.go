@@ -0,0 +1,176 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type dataSourceEmailsType struct{}
+
+func (r dataSourceEmailsType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"folder": {
+				Type:     types.StringType,
+				Optional: true,
+			},
+			"program": {
+				Type:     types.StringType,
+				Optional: true,
+			},
+			"status": {
+				Type:     types.StringType,
+				Optional: true,
+				Validators: []tfsdk.AttributeValidator{
+					stringOneOf{"approved", "draft"},
+				},
+			},
+			"updated_after": {
+				Type:       types.StringType,
+				Optional:   true,
+				Validators: []tfsdk.AttributeValidator{rfc3339{}},
+			},
+			"updated_before": {
+				Type:       types.StringType,
+				Optional:   true,
+				Validators: []tfsdk.AttributeValidator{rfc3339{}},
+			},
+			"emails": {
+				Computed: true,
+				Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
+					"id": {
+						Type:     types.StringType,
+						Computed: true,
+					},
+					"last_updated": {
+						Type:     types.StringType,
+						Computed: true,
+					},
+					"name": {
+						Type:     types.StringType,
+						Computed: true,
+					},
+					"description": {
+						Type:     types.StringType,
+						Computed: true,
+					},
+					"folder": {
+						Type:     types.StringType,
+						Computed: true,
+					},
+					"program": {
+						Type:     types.StringType,
+						Computed: true,
+					},
+					"from_email": {
+						Type:     types.StringType,
+						Computed: true,
+					},
+					"from_name": {
+						Type:     types.StringType,
+						Computed: true,
+					},
+					"reply_to": {
+						Type:     types.StringType,
+						Computed: true,
+					},
+					"operational": {
+						Type:     types.BoolType,
+						Computed: true,
+					},
+					"text_only": {
+						Type:     types.BoolType,
+						Computed: true,
+					},
+					"subject": {
+						Type:     types.StringType,
+						Computed: true,
+					},
+					"template": {
+						Type:     types.StringType,
+						Computed: true,
+					},
+					"status": {
+						Type:     types.StringType,
+						Computed: true,
+					},
+				}, tfsdk.ListNestedAttributesOptions{}),
+			},
+		},
+	}, nil
+}
+
+func (r dataSourceEmailsType) NewDataSource(_ context.Context, p tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	return dataSourceEmails{
+		p: p.(*provider),
+	}, nil
+}
+
+type dataSourceEmails struct {
+	p *provider
+}
+
+func (r dataSourceEmails) Read(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
+	if !r.p.ready(&resp.Diagnostics) {
+		return
+	}
+
+	var data Emails
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, err := listFilter(data.Folder, data.Program, data.Status, data.UpdatedAfter, data.UpdatedBefore)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid folder",
+			err.Error(),
+		)
+		return
+	}
+
+	emails, err := r.p.client().ListEmails(ctx, filter)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading emails",
+			"Could not list emails: "+err.Error(),
+		)
+		return
+	}
+
+	data.ID = types.String{Value: "emails"}
+	data.Emails = make([]EmailSummary, 0, len(emails))
+	for _, email := range emails {
+		summary := EmailSummary{
+			ID:          types.String{Value: strconv.Itoa(email.ID)},
+			LastUpdated: timestamp(email.UpdatedAt),
+			Name:        types.String{Value: email.Name},
+			Description: types.String{Value: email.Description},
+			Folder:      types.String{Null: true},
+			Program:     types.String{Null: true},
+			FromEmail:   types.String{Value: email.FromEmail.Value},
+			FromName:    types.String{Value: email.FromName.Value},
+			ReplyTo:     types.String{Value: email.ReplyEmail.Value},
+			Operational: types.Bool{Value: email.Operational},
+			TextOnly:    types.Bool{Value: email.TextOnly},
+			Subject:     types.String{Value: email.Subject.Value},
+			Template:    types.String{Value: strconv.Itoa(email.Template)},
+			Status:      types.String{Value: email.Status},
+		}
+		setParent(email.Folder, &summary.Folder, &summary.Program)
+		data.Emails = append(data.Emails, summary)
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
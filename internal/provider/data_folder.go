@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type dataSourceFolderType struct{}
+
+func (r dataSourceFolderType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"path": {
+				Type:        types.StringType,
+				Required:    true,
+				Description: "Path of the folder starting at a root folder, for example Marketing Activities/Events/HashiTalks.",
+			},
+			"name": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"type": {
+				Type:        types.StringType,
+				Computed:    true,
+				Description: "Folder or Program. Use the id as folder or program of other assets accordingly.",
+			},
+			"workspace": {
+				Type:        types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Description: "Defaults to the workspace of the provider.",
+			},
+		},
+	}, nil
+}
+
+func (r dataSourceFolderType) NewDataSource(_ context.Context, p tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	return dataSourceFolder{
+		p: p.(*provider),
+	}, nil
+}
+
+type dataSourceFolder struct {
+	p *provider
+}
+
+func (r dataSourceFolder) Read(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
+	if !r.p.ready(&resp.Diagnostics) {
+		return
+	}
+
+	var data FolderPath
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	folder, err := r.p.client().GetFolderByPath(ctx, data.Path.Value, data.Workspace.Value)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading folder",
+			"Could not find folder "+strconv.Quote(data.Path.Value)+": "+err.Error(),
+		)
+		return
+	}
+
+	data.ID = types.String{Value: strconv.Itoa(folder.FolderID.ID)}
+	data.Name = types.String{Value: folder.Name}
+	data.Type = types.String{Value: folder.FolderID.Type}
+	data.Workspace = types.String{Value: folder.Workspace}
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,140 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type dataSourceProgramType struct{}
+
+func (r dataSourceProgramType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"last_updated": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"name": {
+				Type:     types.StringType,
+				Required: true,
+			},
+			"description": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"type": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"channel": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"folder": {
+				Type:        types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Description: "Only find the program in this folder.",
+			},
+			"program": {
+				Type:     types.StringType,
+				Optional: true,
+				Computed: true,
+			},
+			"workspace": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"status": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"assets": {
+				Type: types.MapType{
+					ElemType: types.MapType{
+						ElemType: types.StringType,
+					},
+				},
+				Computed: true,
+			},
+			"tags": {
+				Type: types.MapType{
+					ElemType: types.StringType,
+				},
+				Computed: true,
+			},
+		},
+	}, nil
+}
+
+func (r dataSourceProgramType) NewDataSource(_ context.Context, p tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	return dataSourceProgram{
+		p: p.(*provider),
+	}, nil
+}
+
+type dataSourceProgram struct {
+	p *provider
+}
+
+func (r dataSourceProgram) Read(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
+	if !r.p.ready(&resp.Diagnostics) {
+		return
+	}
+
+	var data ProgramData
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	folder, err := scopeFolder(data.Folder, data.Program)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid folder",
+			err.Error(),
+		)
+		return
+	}
+
+	program, err := r.p.client().GetProgramByName(ctx, data.Name.Value, folder)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading program",
+			"Could not find program "+strconv.Quote(data.Name.Value)+": "+err.Error(),
+		)
+		return
+	}
+
+	programID := strconv.Itoa(program.ID)
+	assets, err := r.p.client().GetProgramAssets(ctx, programID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading program",
+			"Could not list assets of program with ID "+programID+": "+err.Error(),
+		)
+		return
+	}
+
+	data.ID = types.String{Value: programID}
+	data.LastUpdated = timestamp(program.UpdatedAt)
+	data.Description = types.String{Value: program.Description}
+	data.Type = types.String{Value: program.Type}
+	data.Channel = types.String{Value: program.Channel}
+	setParent(program.Folder, &data.Folder, &data.Program)
+	data.Workspace = types.String{Value: program.Workspace}
+	data.Status = types.String{Value: program.Status}
+	data.Assets = programAssets(assets)
+	data.Tags = tagsFromAPI(program.Tags)
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type dataSourceProgramsType struct{}
+
+func (r dataSourceProgramsType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"folder": {
+				Type:     types.StringType,
+				Optional: true,
+			},
+			"status": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "Status of the programs, for example locked or unlocked for email programs and on or off for engagement programs.",
+			},
+			"tag_type": {
+				Type:     types.StringType,
+				Optional: true,
+			},
+			"tag_value": {
+				Type:     types.StringType,
+				Optional: true,
+			},
+			"channel": {
+				Type:     types.StringType,
+				Optional: true,
+			},
+			"updated_after": {
+				Type:       types.StringType,
+				Optional:   true,
+				Validators: []tfsdk.AttributeValidator{rfc3339{}},
+			},
+			"updated_before": {
+				Type:       types.StringType,
+				Optional:   true,
+				Validators: []tfsdk.AttributeValidator{rfc3339{}},
+			},
+			"programs": {
+				Computed: true,
+				Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
+					"id": {
+						Type:     types.StringType,
+						Computed: true,
+					},
+					"last_updated": {
+						Type:     types.StringType,
+						Computed: true,
+					},
+					"name": {
+						Type:     types.StringType,
+						Computed: true,
+					},
+					"description": {
+						Type:     types.StringType,
+						Computed: true,
+					},
+					"type": {
+						Type:     types.StringType,
+						Computed: true,
+					},
+					"channel": {
+						Type:     types.StringType,
+						Computed: true,
+					},
+					"folder": {
+						Type:     types.StringType,
+						Computed: true,
+					},
+					"workspace": {
+						Type:     types.StringType,
+						Computed: true,
+					},
+					"status": {
+						Type:     types.StringType,
+						Computed: true,
+					},
+				}, tfsdk.ListNestedAttributesOptions{}),
+			},
+		},
+	}, nil
+}
+
+func (r dataSourceProgramsType) NewDataSource(_ context.Context, p tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	return dataSourcePrograms{
+		p: p.(*provider),
+	}, nil
+}
+
+type dataSourcePrograms struct {
+	p *provider
+}
+
+func (r dataSourcePrograms) ValidateConfig(ctx context.Context, req tfsdk.ValidateDataSourceConfigRequest, resp *tfsdk.ValidateDataSourceConfigResponse) {
+	var config Programs
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.TagType.Null != config.TagValue.Null {
+		resp.Diagnostics.AddError(
+			"Invalid tag filter",
+			"tag_type and tag_value must be set together.",
+		)
+	}
+}
+
+func (r dataSourcePrograms) Read(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
+	if !r.p.ready(&resp.Diagnostics) {
+		return
+	}
+
+	var data Programs
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, err := listFilter(data.Folder, types.String{Null: true}, data.Status, data.UpdatedAfter, data.UpdatedBefore)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid folder",
+			err.Error(),
+		)
+		return
+	}
+	filter.TagType = data.TagType.Value
+	filter.TagValue = data.TagValue.Value
+	filter.Channel = data.Channel.Value
+
+	programs, err := r.p.client().ListPrograms(ctx, filter)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading programs",
+			"Could not list programs: "+err.Error(),
+		)
+		return
+	}
+
+	data.ID = types.String{Value: "programs"}
+	data.Programs = make([]ProgramSummary, 0, len(programs))
+	for _, program := range programs {
+		data.Programs = append(data.Programs, ProgramSummary{
+			ID:          types.String{Value: strconv.Itoa(program.ID)},
+			LastUpdated: timestamp(program.UpdatedAt),
+			Name:        types.String{Value: program.Name},
+			Description: types.String{Value: program.Description},
+			Type:        types.String{Value: program.Type},
+			Channel:     types.String{Value: program.Channel},
+			Folder:      types.String{Value: strconv.Itoa(program.Folder.ID)},
+			Workspace:   types.String{Value: program.Workspace},
+			Status:      types.String{Value: program.Status},
+		})
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type dataSourceSegmentationType struct{}
+
+func (r dataSourceSegmentationType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"last_updated": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"name": {
+				Type:     types.StringType,
+				Required: true,
+			},
+			"description": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"folder": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"approved": {
+				Type:     types.BoolType,
+				Computed: true,
+			},
+			"status": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"segments": {
+				Computed: true,
+				Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
+					"id": {
+						Type:     types.StringType,
+						Computed: true,
+					},
+					"name": {
+						Type:     types.StringType,
+						Computed: true,
+					},
+				}, tfsdk.ListNestedAttributesOptions{}),
+			},
+		},
+	}, nil
+}
+
+func (r dataSourceSegmentationType) NewDataSource(_ context.Context, p tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	return dataSourceSegmentation{
+		p: p.(*provider),
+	}, nil
+}
+
+type dataSourceSegmentation struct {
+	p *provider
+}
+
+func (r dataSourceSegmentation) Read(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
+	if !r.p.ready(&resp.Diagnostics) {
+		return
+	}
+
+	var data SegmentationData
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	segmentation, err := r.p.client().GetSegmentationByName(ctx, data.Name.Value)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading segmentation",
+			"Could not find segmentation "+data.Name.Value+": "+err.Error(),
+		)
+		return
+	}
+
+	segmentationID := strconv.Itoa(segmentation.ID)
+	segments, err := r.p.client().GetSegments(ctx, segmentationID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading segmentation",
+			"Could not read segments of segmentation with ID "+segmentationID+": "+err.Error(),
+		)
+		return
+	}
+
+	data.ID = types.String{Value: segmentationID}
+	data.CreatedAt = timestamp(segmentation.CreatedAt)
+	data.LastUpdated = timestamp(segmentation.UpdatedAt)
+	data.Description = types.String{Value: segmentation.Description}
+	data.Folder = types.String{Value: strconv.Itoa(segmentation.Folder.ID)}
+	data.Approved = types.Bool{Value: segmentation.Status == "approved"}
+	data.Status = types.String{Value: segmentation.Status}
+	data.Segments = segmentsFromAPI(segments)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,122 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/eveld/terraform-provider-marketo/marketo"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type dataSourceSmartCampaignType struct{}
+
+func (r dataSourceSmartCampaignType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"last_updated": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"name": {
+				Type:     types.StringType,
+				Required: true,
+			},
+			"description": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"folder": {
+				Type:        types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Description: "Only find the smart campaign in this folder.",
+			},
+			"program": {
+				Type:        types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Description: "Only find the smart campaign in this program.",
+			},
+			"type": {
+				Type:        types.StringType,
+				Computed:    true,
+				Description: "batch or trigger.",
+			},
+			"status": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"active": {
+				Type:     types.BoolType,
+				Computed: true,
+			},
+		},
+	}, nil
+}
+
+func (r dataSourceSmartCampaignType) NewDataSource(_ context.Context, p tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	return dataSourceSmartCampaign{
+		p: p.(*provider),
+	}, nil
+}
+
+type dataSourceSmartCampaign struct {
+	p *provider
+}
+
+func (r dataSourceSmartCampaign) Read(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
+	if !r.p.ready(&resp.Diagnostics) {
+		return
+	}
+
+	var data SmartCampaignData
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	folder, err := scopeFolder(data.Folder, data.Program)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid folder",
+			err.Error(),
+		)
+		return
+	}
+
+	smartCampaign, err := r.p.client().GetSmartCampaignByName(ctx, data.Name.Value, folder)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading smart campaign",
+			"Could not find smart campaign "+strconv.Quote(data.Name.Value)+": "+err.Error(),
+		)
+		return
+	}
+
+	data = smartCampaignData(smartCampaign)
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func smartCampaignData(smartCampaign *marketo.SmartCampaign) SmartCampaignData {
+	data := SmartCampaignData{
+		ID:          types.String{Value: strconv.Itoa(smartCampaign.ID)},
+		LastUpdated: timestamp(smartCampaign.UpdatedAt),
+		Name:        types.String{Value: smartCampaign.Name},
+		Description: types.String{Value: smartCampaign.Description},
+		Folder:      types.String{Null: true},
+		Program:     types.String{Null: true},
+		Type:        types.String{Value: smartCampaign.Type},
+		Status:      types.String{Value: smartCampaign.Status},
+		Active:      types.Bool{Value: smartCampaign.IsActive},
+	}
+	setParent(smartCampaign.Folder, &data.Folder, &data.Program)
+	return data
+}
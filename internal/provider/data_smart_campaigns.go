@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type dataSourceSmartCampaignsType struct{}
+
+func (r dataSourceSmartCampaignsType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"folder": {
+				Type:     types.StringType,
+				Optional: true,
+			},
+			"program": {
+				Type:     types.StringType,
+				Optional: true,
+			},
+			"status": {
+				Type:     types.StringType,
+				Optional: true,
+				Validators: []tfsdk.AttributeValidator{
+					stringOneOf{"active", "inactive"},
+				},
+			},
+			"updated_after": {
+				Type:       types.StringType,
+				Optional:   true,
+				Validators: []tfsdk.AttributeValidator{rfc3339{}},
+			},
+			"updated_before": {
+				Type:       types.StringType,
+				Optional:   true,
+				Validators: []tfsdk.AttributeValidator{rfc3339{}},
+			},
+			"smart_campaigns": {
+				Computed: true,
+				Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
+					"id": {
+						Type:     types.StringType,
+						Computed: true,
+					},
+					"last_updated": {
+						Type:     types.StringType,
+						Computed: true,
+					},
+					"name": {
+						Type:     types.StringType,
+						Computed: true,
+					},
+					"description": {
+						Type:     types.StringType,
+						Computed: true,
+					},
+					"folder": {
+						Type:     types.StringType,
+						Computed: true,
+					},
+					"program": {
+						Type:     types.StringType,
+						Computed: true,
+					},
+					"type": {
+						Type:     types.StringType,
+						Computed: true,
+					},
+					"status": {
+						Type:     types.StringType,
+						Computed: true,
+					},
+					"active": {
+						Type:     types.BoolType,
+						Computed: true,
+					},
+				}, tfsdk.ListNestedAttributesOptions{}),
+			},
+		},
+	}, nil
+}
+
+func (r dataSourceSmartCampaignsType) NewDataSource(_ context.Context, p tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	return dataSourceSmartCampaigns{
+		p: p.(*provider),
+	}, nil
+}
+
+type dataSourceSmartCampaigns struct {
+	p *provider
+}
+
+func (r dataSourceSmartCampaigns) Read(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
+	if !r.p.ready(&resp.Diagnostics) {
+		return
+	}
+
+	var data SmartCampaigns
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, err := listFilter(data.Folder, data.Program, data.Status, data.UpdatedAfter, data.UpdatedBefore)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid folder",
+			err.Error(),
+		)
+		return
+	}
+
+	smartCampaigns, err := r.p.client().ListSmartCampaigns(ctx, filter)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading smart campaigns",
+			"Could not list smart campaigns: "+err.Error(),
+		)
+		return
+	}
+
+	data.ID = types.String{Value: "smart_campaigns"}
+	data.SmartCampaigns = make([]SmartCampaignData, 0, len(smartCampaigns))
+	for i := range smartCampaigns {
+		data.SmartCampaigns = append(data.SmartCampaigns, smartCampaignData(&smartCampaigns[i]))
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
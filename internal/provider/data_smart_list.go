@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
@@ -31,23 +32,37 @@ func (r dataSourceSmartListType) GetSchema(_ context.Context) (tfsdk.Schema, dia
 
 func (r dataSourceSmartListType) NewDataSource(_ context.Context, p tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
 	return dataSourceSmartList{
-		p: *(p.(*provider)),
+		p: p.(*provider),
 	}, nil
 }
 
 type dataSourceSmartList struct {
-	p provider
+	p *provider
 }
 
 func (r dataSourceSmartList) Read(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
-	var data SmartList
+	if !r.p.ready(&resp.Diagnostics) {
+		return
+	}
+
+	var data SmartListData
 	diags := req.Config.Get(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	data.ID = types.String{Value: "x"}
+	smartList, err := r.p.client().GetSmartListByName(ctx, data.Name.Value)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading smart list",
+			"Could not find smart list "+strconv.Quote(data.Name.Value)+": "+err.Error(),
+		)
+		return
+	}
+
+	data.ID = types.String{Value: strconv.Itoa(smartList.ID)}
+	data.LastUpdated = timestamp(smartList.UpdatedAt)
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 }
@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type dataSourceTagTypesType struct{}
+
+func (r dataSourceTagTypesType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"tag_types": {
+				Computed: true,
+				Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
+					"name": {
+						Type:     types.StringType,
+						Computed: true,
+					},
+					"applicable_program_types": {
+						Type: types.ListType{
+							ElemType: types.StringType,
+						},
+						Computed: true,
+					},
+					"required": {
+						Type:     types.BoolType,
+						Computed: true,
+					},
+					"allowable_values": {
+						Type: types.ListType{
+							ElemType: types.StringType,
+						},
+						Computed: true,
+					},
+				}, tfsdk.ListNestedAttributesOptions{}),
+			},
+		},
+	}, nil
+}
+
+func (r dataSourceTagTypesType) NewDataSource(_ context.Context, p tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	return dataSourceTagTypes{
+		p: p.(*provider),
+	}, nil
+}
+
+type dataSourceTagTypes struct {
+	p *provider
+}
+
+func (r dataSourceTagTypes) Read(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
+	if !r.p.ready(&resp.Diagnostics) {
+		return
+	}
+
+	var data TagTypes
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tagTypes, err := r.p.client().ListTagTypes(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading tag types",
+			"Could not list tag types: "+err.Error(),
+		)
+		return
+	}
+
+	data.ID = types.String{Value: "tag_types"}
+	data.TagTypes = make([]TagType, 0, len(tagTypes))
+	for _, tagType := range tagTypes {
+		data.TagTypes = append(data.TagTypes, TagType{
+			Name:                   types.String{Value: tagType.Name},
+			ApplicableProgramTypes: append([]string{}, tagType.ProgramTypes()...),
+			Required:               types.Bool{Value: tagType.Required},
+			AllowableValues:        append([]string{}, tagType.Values()...),
+		})
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type dataSourceWorkspaceType struct{}
+
+func (r dataSourceWorkspaceType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"name": {
+				Type:        types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Description: "Defaults to the workspace of the provider.",
+			},
+			"root_folder": {
+				Type:        types.StringType,
+				Computed:    true,
+				Description: "ID of the Marketing Activities folder of the workspace.",
+			},
+		},
+	}, nil
+}
+
+func (r dataSourceWorkspaceType) NewDataSource(_ context.Context, p tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	return dataSourceWorkspace{
+		p: p.(*provider),
+	}, nil
+}
+
+type dataSourceWorkspace struct {
+	p *provider
+}
+
+func (r dataSourceWorkspace) Read(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
+	if !r.p.ready(&resp.Diagnostics) {
+		return
+	}
+
+	var data Workspace
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := data.Name.Value
+	if data.Name.Null {
+		name = r.p.client().Workspace
+	}
+	if name == "" {
+		name = "Default"
+	}
+
+	root, err := r.p.client().GetWorkspaceRoot(ctx, name)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading workspace",
+			"Could not find the root folder of workspace "+strconv.Quote(name)+": "+err.Error(),
+		)
+		return
+	}
+
+	data.ID = types.String{Value: root.Workspace}
+	data.Name = types.String{Value: root.Workspace}
+	data.RootFolder = types.String{Value: strconv.Itoa(root.ID)}
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
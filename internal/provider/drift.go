@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Drift policies for changes made to approvable assets and email programs
+// outside of Terraform.
+// With driftPolicyDiff the change is read into state, so the next plan
+// reverts it. With driftPolicyWarn it is reported as a warning and state
+// keeps what Terraform last wrote.
+const (
+	driftPolicyDiff = "diff"
+	driftPolicyWarn = "warn"
+)
+
+// contentHash hashes the content of an asset as read back from the API, so
+// that it can be compared with the content Terraform last wrote.
+func contentHash(parts ...string) string {
+	h := sha256.New()
+	for _, part := range parts {
+		h.Write([]byte(part))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// keepOnDrift reports whether Read should keep the state Terraform last
+// wrote instead of refreshing it. That is the case when the asset changed
+// since lastApplied and hash were recorded and the drift policy is warn, in
+// which case a warning is added to diags. Assets without a record, such as
+// imported ones, never drift.
+func (p *provider) keepOnDrift(diags *diag.Diagnostics, kind string, id string, lastApplied types.String, hash types.String, updatedAt string, currentHash string) bool {
+	if lastApplied.Null || lastApplied.Unknown || hash.Null || hash.Unknown {
+		return false
+	}
+
+	changedAt := timestamp(updatedAt).Value
+	if changedAt == lastApplied.Value && currentHash == hash.Value {
+		return false
+	}
+
+	what := "was edited"
+	if currentHash == hash.Value {
+		what = "was saved without changes to its content"
+	}
+	return p.keepChange(diags, kind, id, what, changedAt, lastApplied.Value)
+}
+
+// keepChange reports whether the drift policy is warn, in which case it adds
+// a warning that the kind with ID id what in Marketo at changedAt, after
+// Terraform last wrote it at writtenAt.
+func (p *provider) keepChange(diags *diag.Diagnostics, kind string, id string, what string, changedAt string, writtenAt string) bool {
+	p.mu.RLock()
+	policy := p.driftPolicy
+	p.mu.RUnlock()
+
+	if policy != driftPolicyWarn {
+		return false
+	}
+
+	diags.AddWarning(
+		"Asset changed outside of Terraform",
+		fmt.Sprintf("The %s with ID %s %s in Marketo at %s, after Terraform last wrote it at %s. "+
+			"The asset API does not report who made the change, the Audit Trail in the Marketo admin does.\n\n"+
+			"Because drift_policy is %q the change is kept and not planned away. "+
+			"It is overwritten the next time the configuration of this %s changes.",
+			kind, id, what, changedAt, writtenAt, driftPolicyWarn, kind),
+	)
+	return true
+}
@@ -0,0 +1,234 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/eveld/terraform-provider-marketo/marketo"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// parentFolder resolves the mutually exclusive folder and program attributes
+// into the parent reference the asset API expects.
+func parentFolder(folder types.String, program types.String) (marketo.FolderID, error) {
+	if !folder.Null && !program.Null {
+		return marketo.FolderID{}, errors.New("only one of folder or program can be set")
+	}
+
+	value, kind := folder.Value, "Folder"
+	if !program.Null {
+		value, kind = program.Value, "Program"
+	}
+
+	if value == "" {
+		return marketo.FolderID{}, errors.New("one of folder or program must be set")
+	}
+
+	id, err := strconv.Atoi(value)
+	if err != nil {
+		return marketo.FolderID{}, errors.New(kind + " must be a numeric ID, got " + strconv.Quote(value))
+	}
+
+	return marketo.FolderID{ID: id, Type: kind}, nil
+}
+
+// workspaceParent is parentFolder for assets that default to the root folder
+// of their workspace. It returns an empty reference when neither folder nor
+// program is set.
+func workspaceParent(folder types.String, program types.String) (marketo.FolderID, error) {
+	if folder.Null && program.Null {
+		return marketo.FolderID{}, nil
+	}
+	return parentFolder(folder, program)
+}
+
+// scopeFolder is parentFolder for lookups that can optionally be limited to
+// a folder or program. It returns nil when neither is set.
+func scopeFolder(folder types.String, program types.String) (*marketo.FolderID, error) {
+	if folder.Null && program.Null {
+		return nil, nil
+	}
+
+	parent, err := parentFolder(folder, program)
+	if err != nil {
+		return nil, err
+	}
+	return &parent, nil
+}
+
+// listFilter builds the filter shared by the plural data sources from their
+// optional attributes.
+func listFilter(folder, program, status, updatedAfter, updatedBefore types.String) (marketo.ListFilter, error) {
+	parent, err := scopeFolder(folder, program)
+	if err != nil {
+		return marketo.ListFilter{}, err
+	}
+
+	return marketo.ListFilter{
+		Folder:        parent,
+		Status:        status.Value,
+		UpdatedAfter:  updatedAfter.Value,
+		UpdatedBefore: updatedBefore.Value,
+	}, nil
+}
+
+// setParent is the inverse of parentFolder and writes the parent reference
+// returned by the asset API back into the folder or program attribute.
+func setParent(parent marketo.FolderID, folder *types.String, program *types.String) {
+	if parent.ID == 0 {
+		return
+	}
+
+	if parent.Type == "Program" {
+		*program = types.String{Value: strconv.Itoa(parent.ID)}
+		*folder = types.String{Null: true}
+		return
+	}
+
+	*folder = types.String{Value: strconv.Itoa(parent.ID)}
+	*program = types.String{Null: true}
+}
+
+// importCompositeID splits an import ID of the form "<a>/<b>" and writes each
+// part into the attribute of the same position in names.
+func importCompositeID(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse, names ...string) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != len(names) {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			"Expected an ID of the form <"+strings.Join(names, ">/<")+">, got "+strconv.Quote(req.ID),
+		)
+		return
+	}
+
+	for i, name := range names {
+		diags := resp.State.SetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName(name), types.String{Value: parts[i]})
+		resp.Diagnostics.Append(diags...)
+	}
+}
+
+// importByName accepts import IDs of the form "<kind>:<name>" next to plain
+// IDs. The name is resolved into the ID of the asset before it is written to
+// the id attribute, after which Read fills in the rest.
+func importByName(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse, kind string, resolve func(name string) (int, error)) {
+	idPath := tftypes.NewAttributePath().WithAttributeName("id")
+
+	name := strings.TrimPrefix(req.ID, kind+":")
+	if name == req.ID {
+		tfsdk.ResourceImportStatePassthroughID(ctx, idPath, req, resp)
+		return
+	}
+
+	id, err := resolve(name)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing "+kind,
+			"Could not find "+kind+" "+strconv.Quote(name)+": "+err.Error(),
+		)
+		return
+	}
+
+	diags := resp.State.SetAttribute(ctx, idPath, types.String{Value: strconv.Itoa(id)})
+	resp.Diagnostics.Append(diags...)
+}
+
+// setImportedParent is setParent for folders and programs that were just
+// imported. A parent that is the root of the workspace is left empty, like
+// it is when the asset was created without one.
+func setImportedParent(ctx context.Context, client *marketo.Client, parent marketo.FolderID, workspace string, folder *types.String, program *types.String) error {
+	root, err := client.GetWorkspaceRoot(ctx, workspace)
+	if err != nil {
+		return err
+	}
+
+	if parent.ID != root.FolderID.ID {
+		setParent(parent, folder, program)
+	}
+	return nil
+}
+
+// timestamp converts a createdAt or updatedAt of the asset API to RFC3339 in
+// UTC, so that timestamps in state compare and sort the same way.
+func timestamp(value string) types.String {
+	if value == "" {
+		return types.String{Null: true}
+	}
+
+	t, err := marketo.ParseTime(value)
+	if err != nil {
+		return types.String{Value: value}
+	}
+	return types.String{Value: t.UTC().Format(time.RFC3339)}
+}
+
+// containerTimestamps returns the timestamps of a folder or program, for
+// resources that manage settings of one rather than an asset of their own.
+func containerTimestamps(ctx context.Context, client *marketo.Client, container marketo.FolderID) (types.String, types.String, error) {
+	id := strconv.Itoa(container.ID)
+	if container.Type == "Program" {
+		return programTimestamps(ctx, client, id)
+	}
+
+	folder, err := client.GetFolder(ctx, id)
+	if err != nil {
+		return types.String{}, types.String{}, err
+	}
+	return timestamp(folder.CreatedAt), timestamp(folder.UpdatedAt), nil
+}
+
+func programTimestamps(ctx context.Context, client *marketo.Client, programID string) (types.String, types.String, error) {
+	program, err := client.GetProgram(ctx, programID)
+	if err != nil {
+		return types.String{}, types.String{}, err
+	}
+	return timestamp(program.CreatedAt), timestamp(program.UpdatedAt), nil
+}
+
+func listTimestamps(ctx context.Context, client *marketo.Client, listID string) (types.String, types.String, error) {
+	staticList, err := client.GetStaticList(ctx, listID)
+	if err != nil {
+		return types.String{}, types.String{}, err
+	}
+	return timestamp(staticList.CreatedAt), timestamp(staticList.UpdatedAt), nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// keepCreated stores plan as the state of a resource that was created in
+// Marketo, but could not be set up completely. plan must hold the ID of the
+// asset, values that are still unknown are stored as null. Terraform taints
+// the resource, so the next apply replaces it rather than leaving it behind.
+func keepCreated(ctx context.Context, resp *tfsdk.CreateResourceResponse, plan interface{}) {
+	diags := resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+
+	raw, err := tftypes.Transform(resp.State.Raw, func(_ *tftypes.AttributePath, value tftypes.Value) (tftypes.Value, error) {
+		if !value.IsKnown() {
+			return tftypes.NewValue(value.Type(), nil), nil
+		}
+		return value, nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error storing state",
+			"Could not store the state of the partially created resource: "+err.Error(),
+		)
+		return
+	}
+	resp.State.Raw = raw
+}
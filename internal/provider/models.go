@@ -3,43 +3,432 @@ package provider
 import "github.com/hashicorp/terraform-plugin-framework/types"
 
 type Channel struct {
+	ID                    types.String `tfsdk:"id"`
+	LastUpdated           types.String `tfsdk:"last_updated"`
+	Name                  types.String `tfsdk:"name"`
+	ApplicableProgramType types.String `tfsdk:"applicable_program_type"`
+}
+
+type Channels struct {
+	ID       types.String `tfsdk:"id"`
+	Channels []Channel    `tfsdk:"channels"`
+}
+
+type TagTypes struct {
+	ID       types.String `tfsdk:"id"`
+	TagTypes []TagType    `tfsdk:"tag_types"`
+}
+
+type TagType struct {
+	Name                   types.String `tfsdk:"name"`
+	ApplicableProgramTypes []string     `tfsdk:"applicable_program_types"`
+	Required               types.Bool   `tfsdk:"required"`
+	AllowableValues        []string     `tfsdk:"allowable_values"`
+}
+
+type Program struct {
+	ID           types.String  `tfsdk:"id"`
+	LastUpdated  types.String  `tfsdk:"last_updated"`
+	CreatedAt    types.String  `tfsdk:"created_at"`
+	Name         types.String  `tfsdk:"name"`
+	Description  types.String  `tfsdk:"description"`
+	Type         types.String  `tfsdk:"type"`
+	Channel      types.String  `tfsdk:"channel"`
+	Folder       types.String  `tfsdk:"folder"`
+	Program      types.String  `tfsdk:"program"`
+	CloneFrom    types.String  `tfsdk:"clone_from"`
+	Assets       types.Map     `tfsdk:"assets"`
+	EmailProgram *EmailProgram `tfsdk:"email_program"`
+	Event        *Event        `tfsdk:"event"`
+	Tags         types.Map     `tfsdk:"tags"`
+	Workspace    types.String  `tfsdk:"workspace"`
+	Timeouts     *Timeouts     `tfsdk:"timeouts"`
+}
+
+// ProgramData is the program as looked up by data sources. Email program and
+// event settings are left out, they are only managed by the resource.
+type ProgramData struct {
 	ID          types.String `tfsdk:"id"`
 	LastUpdated types.String `tfsdk:"last_updated"`
 	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Type        types.String `tfsdk:"type"`
+	Channel     types.String `tfsdk:"channel"`
+	Folder      types.String `tfsdk:"folder"`
+	Program     types.String `tfsdk:"program"`
+	Workspace   types.String `tfsdk:"workspace"`
+	Status      types.String `tfsdk:"status"`
+	Assets      types.Map    `tfsdk:"assets"`
+	Tags        types.Map    `tfsdk:"tags"`
 }
 
-type Program struct {
+type Programs struct {
+	ID            types.String     `tfsdk:"id"`
+	Folder        types.String     `tfsdk:"folder"`
+	Status        types.String     `tfsdk:"status"`
+	TagType       types.String     `tfsdk:"tag_type"`
+	TagValue      types.String     `tfsdk:"tag_value"`
+	Channel       types.String     `tfsdk:"channel"`
+	UpdatedAfter  types.String     `tfsdk:"updated_after"`
+	UpdatedBefore types.String     `tfsdk:"updated_before"`
+	Programs      []ProgramSummary `tfsdk:"programs"`
+}
+
+// ProgramSummary is a program as returned by list endpoints, without the
+// assets and tags that take extra requests per program.
+type ProgramSummary struct {
 	ID          types.String `tfsdk:"id"`
 	LastUpdated types.String `tfsdk:"last_updated"`
 	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Type        types.String `tfsdk:"type"`
+	Channel     types.String `tfsdk:"channel"`
+	Folder      types.String `tfsdk:"folder"`
+	Workspace   types.String `tfsdk:"workspace"`
+	Status      types.String `tfsdk:"status"`
+}
+
+type Event struct {
+	StartAt types.String `tfsdk:"start_at"`
+	EndAt   types.String `tfsdk:"end_at"`
+	Webinar *Webinar     `tfsdk:"webinar"`
+}
+
+type Webinar struct {
+	Provider types.String `tfsdk:"provider"`
+	EventID  types.String `tfsdk:"event_id"`
+}
+
+type EmailProgram struct {
+	SendAt            types.String `tfsdk:"send_at"`
+	RecipientTimeZone types.Bool   `tfsdk:"recipient_time_zone"`
+	HeadStart         types.Bool   `tfsdk:"head_start"`
+	Approved          types.Bool   `tfsdk:"approved"`
+	ABTest            *ABTest      `tfsdk:"ab_test"`
+}
+
+type ABTest struct {
+	Type           types.String `tfsdk:"type"`
+	SampleSize     types.Int64  `tfsdk:"sample_size"`
+	WinnerCriteria types.String `tfsdk:"winner_criteria"`
+	WinnerSendAt   types.String `tfsdk:"winner_send_at"`
 }
 
 type Folder struct {
 	ID          types.String `tfsdk:"id"`
 	LastUpdated types.String `tfsdk:"last_updated"`
+	CreatedAt   types.String `tfsdk:"created_at"`
 	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Folder      types.String `tfsdk:"folder"`
+	Program     types.String `tfsdk:"program"`
+	Workspace   types.String `tfsdk:"workspace"`
+	Timeouts    *Timeouts    `tfsdk:"timeouts"`
+}
+
+type FolderPath struct {
+	ID        types.String `tfsdk:"id"`
+	Path      types.String `tfsdk:"path"`
+	Name      types.String `tfsdk:"name"`
+	Type      types.String `tfsdk:"type"`
+	Workspace types.String `tfsdk:"workspace"`
+}
+
+type Workspace struct {
+	ID         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	RootFolder types.String `tfsdk:"root_folder"`
 }
 
 type Email struct {
+	ID          types.String   `tfsdk:"id"`
+	LastUpdated types.String   `tfsdk:"last_updated"`
+	CreatedAt   types.String   `tfsdk:"created_at"`
+	Name        types.String   `tfsdk:"name"`
+	Description types.String   `tfsdk:"description"`
+	Folder      types.String   `tfsdk:"folder"`
+	Program     types.String   `tfsdk:"program"`
+	FromEmail   types.String   `tfsdk:"from_email"`
+	FromName    types.String   `tfsdk:"from_name"`
+	ReplyTo     types.String   `tfsdk:"reply_to"`
+	Operational types.Bool     `tfsdk:"operational"`
+	TextOnly    types.Bool     `tfsdk:"text_only"`
+	Subject     types.String   `tfsdk:"subject"`
+	Template    types.String   `tfsdk:"template"`
+	Content     []EmailContent `tfsdk:"content"`
+	LastApplied types.String   `tfsdk:"last_applied"`
+	ContentHash types.String   `tfsdk:"content_hash"`
+	Timeouts    *Timeouts      `tfsdk:"timeouts"`
+}
+
+// EmailData is the email as looked up by data sources, which do not track
+// drift.
+type EmailData struct {
+	ID          types.String   `tfsdk:"id"`
+	LastUpdated types.String   `tfsdk:"last_updated"`
+	CreatedAt   types.String   `tfsdk:"created_at"`
+	Name        types.String   `tfsdk:"name"`
+	Description types.String   `tfsdk:"description"`
+	Folder      types.String   `tfsdk:"folder"`
+	Program     types.String   `tfsdk:"program"`
+	FromEmail   types.String   `tfsdk:"from_email"`
+	FromName    types.String   `tfsdk:"from_name"`
+	ReplyTo     types.String   `tfsdk:"reply_to"`
+	Operational types.Bool     `tfsdk:"operational"`
+	TextOnly    types.Bool     `tfsdk:"text_only"`
+	Subject     types.String   `tfsdk:"subject"`
+	Template    types.String   `tfsdk:"template"`
+	Content     []EmailContent `tfsdk:"content"`
+}
+
+type Emails struct {
+	ID            types.String   `tfsdk:"id"`
+	Folder        types.String   `tfsdk:"folder"`
+	Program       types.String   `tfsdk:"program"`
+	Status        types.String   `tfsdk:"status"`
+	UpdatedAfter  types.String   `tfsdk:"updated_after"`
+	UpdatedBefore types.String   `tfsdk:"updated_before"`
+	Emails        []EmailSummary `tfsdk:"emails"`
+}
+
+// EmailSummary is an email as returned by list endpoints, without the content
+// sections that take an extra request per email.
+type EmailSummary struct {
 	ID          types.String `tfsdk:"id"`
 	LastUpdated types.String `tfsdk:"last_updated"`
 	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Folder      types.String `tfsdk:"folder"`
+	Program     types.String `tfsdk:"program"`
+	FromEmail   types.String `tfsdk:"from_email"`
+	FromName    types.String `tfsdk:"from_name"`
+	ReplyTo     types.String `tfsdk:"reply_to"`
+	Operational types.Bool   `tfsdk:"operational"`
+	TextOnly    types.Bool   `tfsdk:"text_only"`
+	Subject     types.String `tfsdk:"subject"`
+	Template    types.String `tfsdk:"template"`
+	Status      types.String `tfsdk:"status"`
+}
+
+type EmailContent struct {
+	Section        types.String `tfsdk:"section"`
+	Text           types.String `tfsdk:"text"`
+	DynamicContent types.String `tfsdk:"dynamic_content"`
+	Snippet        types.String `tfsdk:"snippet"`
 }
 
 type EmailTemplate struct {
 	ID          types.String `tfsdk:"id"`
 	LastUpdated types.String `tfsdk:"last_updated"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Folder      types.String `tfsdk:"folder"`
+	Program     types.String `tfsdk:"program"`
+	Content     types.String `tfsdk:"content"`
+	LastApplied types.String `tfsdk:"last_applied"`
+	ContentHash types.String `tfsdk:"content_hash"`
+	Timeouts    *Timeouts    `tfsdk:"timeouts"`
+}
+
+// EmailTemplateData is the email template as looked up by data sources, which
+// do not track drift.
+type EmailTemplateData struct {
+	ID          types.String `tfsdk:"id"`
+	LastUpdated types.String `tfsdk:"last_updated"`
+	CreatedAt   types.String `tfsdk:"created_at"`
 	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Folder      types.String `tfsdk:"folder"`
+	Program     types.String `tfsdk:"program"`
+	Content     types.String `tfsdk:"content"`
 }
 
 type SmartCampaign struct {
+	ID          types.String `tfsdk:"id"`
+	LastUpdated types.String `tfsdk:"last_updated"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Folder      types.String `tfsdk:"folder"`
+	Program     types.String `tfsdk:"program"`
+	Schedule    *Schedule    `tfsdk:"schedule"`
+	Timeouts    *Timeouts    `tfsdk:"timeouts"`
+}
+
+type Schedule struct {
+	RunAt  types.String `tfsdk:"run_at"`
+	Tokens types.Map    `tfsdk:"tokens"`
+}
+
+type SmartCampaigns struct {
+	ID             types.String        `tfsdk:"id"`
+	Folder         types.String        `tfsdk:"folder"`
+	Program        types.String        `tfsdk:"program"`
+	Status         types.String        `tfsdk:"status"`
+	UpdatedAfter   types.String        `tfsdk:"updated_after"`
+	UpdatedBefore  types.String        `tfsdk:"updated_before"`
+	SmartCampaigns []SmartCampaignData `tfsdk:"smart_campaigns"`
+}
+
+// SmartCampaignData is the smart campaign as looked up by data sources, which
+// cannot read back the schedule of a run but do expose its state.
+type SmartCampaignData struct {
 	ID          types.String `tfsdk:"id"`
 	LastUpdated types.String `tfsdk:"last_updated"`
 	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Folder      types.String `tfsdk:"folder"`
+	Program     types.String `tfsdk:"program"`
+	Type        types.String `tfsdk:"type"`
+	Status      types.String `tfsdk:"status"`
+	Active      types.Bool   `tfsdk:"active"`
 }
 
 type SmartList struct {
 	ID          types.String `tfsdk:"id"`
 	LastUpdated types.String `tfsdk:"last_updated"`
+	CreatedAt   types.String `tfsdk:"created_at"`
 	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Folder      types.String `tfsdk:"folder"`
+	Program     types.String `tfsdk:"program"`
+	Source      types.String `tfsdk:"source"`
+	Timeouts    *Timeouts    `tfsdk:"timeouts"`
+}
+
+type SmartListData struct {
+	ID          types.String `tfsdk:"id"`
+	LastUpdated types.String `tfsdk:"last_updated"`
+	Name        types.String `tfsdk:"name"`
+}
+
+type Snippet struct {
+	ID             types.String `tfsdk:"id"`
+	LastUpdated    types.String `tfsdk:"last_updated"`
+	CreatedAt      types.String `tfsdk:"created_at"`
+	Name           types.String `tfsdk:"name"`
+	Description    types.String `tfsdk:"description"`
+	Folder         types.String `tfsdk:"folder"`
+	HTML           types.String `tfsdk:"html"`
+	Text           types.String `tfsdk:"text"`
+	DynamicContent types.String `tfsdk:"dynamic_content"`
+	Approved       types.Bool   `tfsdk:"approved"`
+	Status         types.String `tfsdk:"status"`
+	LastApplied    types.String `tfsdk:"last_applied"`
+	ContentHash    types.String `tfsdk:"content_hash"`
+	Timeouts       *Timeouts    `tfsdk:"timeouts"`
+}
+
+type ProgramTokens struct {
+	ID          types.String `tfsdk:"id"`
+	LastUpdated types.String `tfsdk:"last_updated"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+	Folder      types.String `tfsdk:"folder"`
+	Program     types.String `tfsdk:"program"`
+	Tokens      []Token      `tfsdk:"tokens"`
+	Timeouts    *Timeouts    `tfsdk:"timeouts"`
+}
+
+type Token struct {
+	Name  types.String `tfsdk:"name"`
+	Type  types.String `tfsdk:"type"`
+	Value types.String `tfsdk:"value"`
+}
+
+type StaticList struct {
+	ID          types.String `tfsdk:"id"`
+	LastUpdated types.String `tfsdk:"last_updated"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Folder      types.String `tfsdk:"folder"`
+	Program     types.String `tfsdk:"program"`
+	Timeouts    *Timeouts    `tfsdk:"timeouts"`
+}
+
+type StaticListMembership struct {
+	ID          types.String `tfsdk:"id"`
+	LastUpdated types.String `tfsdk:"last_updated"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+	List        types.String `tfsdk:"list"`
+	Leads       []int64      `tfsdk:"leads"`
+	Timeouts    *Timeouts    `tfsdk:"timeouts"`
+}
+
+type Segmentation struct {
+	ID          types.String `tfsdk:"id"`
+	LastUpdated types.String `tfsdk:"last_updated"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Folder      types.String `tfsdk:"folder"`
+	Approved    types.Bool   `tfsdk:"approved"`
+	Status      types.String `tfsdk:"status"`
+	Segments    types.List   `tfsdk:"segments"`
+	LastApplied types.String `tfsdk:"last_applied"`
+	ContentHash types.String `tfsdk:"content_hash"`
+	Timeouts    *Timeouts    `tfsdk:"timeouts"`
+}
+
+// SegmentationData is the segmentation as looked up by data sources, which do
+// not track drift.
+type SegmentationData struct {
+	ID          types.String `tfsdk:"id"`
+	LastUpdated types.String `tfsdk:"last_updated"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Folder      types.String `tfsdk:"folder"`
+	Approved    types.Bool   `tfsdk:"approved"`
+	Status      types.String `tfsdk:"status"`
+	Segments    types.List   `tfsdk:"segments"`
+}
+
+type File struct {
+	ID          types.String `tfsdk:"id"`
+	LastUpdated types.String `tfsdk:"last_updated"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Folder      types.String `tfsdk:"folder"`
+	Source      types.String `tfsdk:"source"`
+	SourceHash  types.String `tfsdk:"source_hash"`
+	InsertOnly  types.Bool   `tfsdk:"insert_only"`
+	URL         types.String `tfsdk:"url"`
+	MimeType    types.String `tfsdk:"mime_type"`
+	Size        types.Int64  `tfsdk:"size"`
+	Timeouts    *Timeouts    `tfsdk:"timeouts"`
+}
+
+type EngagementStream struct {
+	ID          types.String `tfsdk:"id"`
+	LastUpdated types.String `tfsdk:"last_updated"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+	Program     types.String `tfsdk:"program"`
+	Name        types.String `tfsdk:"name"`
+	Cadence     Cadence      `tfsdk:"cadence"`
+	Timeouts    *Timeouts    `tfsdk:"timeouts"`
+}
+
+type Cadence struct {
+	DayOfWeek  types.String `tfsdk:"day_of_week"`
+	Time       types.String `tfsdk:"time"`
+	Recurrence types.String `tfsdk:"recurrence"`
+}
+
+type EngagementStreamContent struct {
+	ID          types.String    `tfsdk:"id"`
+	LastUpdated types.String    `tfsdk:"last_updated"`
+	CreatedAt   types.String    `tfsdk:"created_at"`
+	Program     types.String    `tfsdk:"program"`
+	Stream      types.String    `tfsdk:"stream"`
+	Content     []StreamContent `tfsdk:"content"`
+	Timeouts    *Timeouts       `tfsdk:"timeouts"`
+}
+
+type StreamContent struct {
+	Type   types.String `tfsdk:"type"`
+	ID     types.String `tfsdk:"id"`
+	Active types.Bool   `tfsdk:"active"`
 }
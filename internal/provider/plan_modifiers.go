@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// useStateForUnknown keeps the prior value of a computed attribute instead of
+// marking it unknown whenever any other attribute changes. Without it an
+// Optional and Computed attribute that also requires replacement would
+// replace the resource on every update.
+type useStateForUnknown struct{}
+
+func (m useStateForUnknown) Description(_ context.Context) string {
+	return "Once set, the value of this attribute in state will not change."
+}
+
+func (m useStateForUnknown) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m useStateForUnknown) Modify(ctx context.Context, req tfsdk.ModifyAttributePlanRequest, resp *tfsdk.ModifyAttributePlanResponse) {
+	if req.AttributeState == nil || resp.AttributePlan == nil {
+		return
+	}
+
+	if isNull(ctx, req.AttributeState) || !isUnknown(ctx, resp.AttributePlan) {
+		return
+	}
+
+	if req.AttributeConfig != nil && !isNull(ctx, req.AttributeConfig) {
+		return
+	}
+
+	resp.AttributePlan = req.AttributeState
+}
+
+func isNull(ctx context.Context, value attr.Value) bool {
+	v, err := value.ToTerraformValue(ctx)
+	return err == nil && v == nil
+}
+
+func isUnknown(ctx context.Context, value attr.Value) bool {
+	v, err := value.ToTerraformValue(ctx)
+	return err == nil && v == tftypes.UnknownValue
+}
@@ -2,122 +2,224 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/eveld/terraform-provider-marketo/marketo"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 func New() tfsdk.Provider {
 	return &provider{}
 }
 
+// provider is shared by pointer between all resources and data sources,
+// which are created before Configure runs. mu guards the fields that
+// Configure sets.
 type provider struct {
-	configured bool
-	client     *marketo.Client
+	mu          sync.RWMutex
+	configured  bool
+	deferred    bool
+	api         *marketo.Client
+	driftPolicy string
+
+	// unconfigured says why the provider is not configured, for the errors
+	// of ready. It is empty until Configure runs.
+	unconfigured string
 }
 
 func (p *provider) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
 	return tfsdk.Schema{
 		Attributes: map[string]tfsdk.Attribute{
 			"id": {
-				Type:     types.StringType,
-				Required: true,
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "Client ID of the API user. Defaults to MARKETO_ID or the credentials file.",
 			},
 			"secret": {
-				Type:     types.StringType,
-				Required: true,
+				Type:        types.StringType,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Client secret of the API user. Defaults to MARKETO_SECRET or the credentials file.",
+			},
+			"profile": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "Profile in the credentials file to take the settings from that are not configured here or in the environment. Defaults to MARKETO_PROFILE or default.",
+			},
+			"credentials_file": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "Path of the credentials file. Defaults to MARKETO_CREDENTIALS_FILE or ~/.marketo/credentials.",
 			},
 			"endpoint": {
-				Type:     types.StringType,
-				Required: true,
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "REST API endpoint, for example https://123-ABC-456.mktorest.com/rest. Conflicts with munchkin_id.",
+			},
+			"munchkin_id": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "Munchkin ID of the instance, for example 123-ABC-456, from which the endpoints are derived. Conflicts with endpoint.",
+				Validators:  []tfsdk.AttributeValidator{munchkinID{}},
+			},
+			"identity_endpoint": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "Identity endpoint that tokens are requested from, for proxied setups. Defaults to the identity endpoint of the instance.",
+			},
+			"workspace": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "Workspace that folders and programs without a parent are created in. Defaults to the Default workspace.",
+			},
+			"request_timeout": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "Timeout of a single request to the API, for example \"1m\". Defaults to 30s. The timeouts block of a resource bounds its operations as a whole.",
+				Validators:  []tfsdk.AttributeValidator{duration{}},
+			},
+			"drift_policy": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "How changes made in Marketo to emails, email templates, snippets, segmentations and the settings of email programs are handled. With \"diff\", the default, they show up in the plan and are reverted on apply, except for segments, which cannot be written and are accepted on apply. With \"warn\" they are reported as a warning and left alone. The Marketo asset API does not report who made a change, so the warning cannot name them; the Audit Trail in Admin does.",
+				Validators:  []tfsdk.AttributeValidator{stringOneOf{driftPolicyDiff, driftPolicyWarn}},
+			},
+			"verify_credentials": {
+				Type:        types.BoolType,
+				Optional:    true,
+				Description: "Fetch a token and probe the permissions of the API user when the provider is configured, so that missing permissions are reported before any resource is touched. Defaults to false.",
 			},
 		},
 	}, nil
 }
 
 type providerData struct {
-	Endpoint types.String `tfsdk:"endpoint"`
-	ID       types.String `tfsdk:"id"`
-	Secret   types.String `tfsdk:"secret"`
+	Endpoint         types.String `tfsdk:"endpoint"`
+	MunchkinID       types.String `tfsdk:"munchkin_id"`
+	IdentityEndpoint types.String `tfsdk:"identity_endpoint"`
+	Profile          types.String `tfsdk:"profile"`
+	CredentialsFile  types.String `tfsdk:"credentials_file"`
+	ID               types.String `tfsdk:"id"`
+	Secret           types.String `tfsdk:"secret"`
+	Workspace        types.String `tfsdk:"workspace"`
+	RequestTimeout   types.String `tfsdk:"request_timeout"`
+	DriftPolicy      types.String `tfsdk:"drift_policy"`
+	Verify           types.Bool   `tfsdk:"verify_credentials"`
 }
 
 func (p *provider) Configure(ctx context.Context, req tfsdk.ConfigureProviderRequest, resp *tfsdk.ConfigureProviderResponse) {
 	var config providerData
 
+	defer func() {
+		for _, d := range resp.Diagnostics {
+			if d.Severity() == diag.SeverityError {
+				p.mu.Lock()
+				p.unconfigured = d.Summary() + ": " + d.Detail()
+				p.mu.Unlock()
+				return
+			}
+		}
+	}()
+
 	diags := req.Config.Get(ctx, &config)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	if config.Endpoint.Unknown {
+	// Configuration that depends on values from other resources is unknown
+	// while planning. The client is then configured on apply, when Terraform
+	// configures the provider again with the known values.
+	unknown := config.unknown()
+	deferred := unknown != ""
+	p.mu.Lock()
+	p.deferred = deferred
+	if deferred {
+		p.unconfigured = "the value of " + unknown + " is not known until apply"
+	}
+	p.mu.Unlock()
+	if deferred {
 		resp.Diagnostics.AddWarning(
-			"Unable to create client",
-			"Cannot use unknown value as endpoint",
+			"Provider configuration deferred",
+			"The value of "+unknown+" is not known until apply. Until then resources keep their prior state and data sources can't be read.",
 		)
 		return
 	}
 
-	var endpoint string
-	if config.Endpoint.Null {
-		endpoint = os.Getenv("MARKETO_ENDPOINT")
-	} else {
-		endpoint = config.Endpoint.Value
-	}
-
-	if endpoint == "" {
+	profile, err := loadProfile(config)
+	if err != nil {
 		resp.Diagnostics.AddError(
-			"Unable to create client",
-			"Endpoint cannot be an empty string",
+			"Unable to read credentials file",
+			err.Error(),
 		)
 		return
 	}
 
-	if config.ID.Unknown {
-		resp.Diagnostics.AddWarning(
-			"Unable to create client",
-			"Cannot use unknown value as id",
+	// Settings come from the configuration, else the environment, else the
+	// profile. Endpoint and munchkin_id are taken from the same place, so
+	// that either one at a higher level wins.
+	endpoint, munchkin := config.Endpoint.Value, config.MunchkinID.Value
+	source := "endpoint and munchkin_id"
+	if config.Endpoint.Null && config.MunchkinID.Null {
+		endpoint, munchkin = os.Getenv("MARKETO_ENDPOINT"), os.Getenv("MARKETO_MUNCHKIN_ID")
+		source = "MARKETO_ENDPOINT and MARKETO_MUNCHKIN_ID"
+	}
+	if endpoint == "" && munchkin == "" {
+		endpoint, munchkin = profile.Endpoint, profile.MunchkinID
+		source = "endpoint and munchkin_id in the credentials file"
+	}
+
+	if endpoint != "" && munchkin != "" {
+		resp.Diagnostics.AddError(
+			"Conflicting endpoint configuration",
+			"Only one of "+source+" can be set, got endpoint "+strconv.Quote(endpoint)+" and Munchkin ID "+strconv.Quote(munchkin)+".",
 		)
 		return
 	}
 
-	var id string
-	if config.ID.Null {
-		id = os.Getenv("MARKETO_ID")
-	} else {
-		id = config.ID.Value
+	if munchkin != "" {
+		endpoint, err = marketo.MunchkinEndpoint(munchkin)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to create client",
+				"Invalid Munchkin ID: "+err.Error(),
+			)
+			return
+		}
 	}
 
-	if id == "" {
+	if endpoint == "" {
 		resp.Diagnostics.AddError(
 			"Unable to create client",
-			"ID cannot be an empty string",
+			"One of endpoint or munchkin_id must be set, as MARKETO_ENDPOINT or MARKETO_MUNCHKIN_ID in the environment or in the credentials file",
 		)
 		return
 	}
 
-	if config.Secret.Unknown {
-		resp.Diagnostics.AddWarning(
+	identityEndpoint := setting(config.IdentityEndpoint, "MARKETO_IDENTITY_ENDPOINT", profile.IdentityEndpoint)
+
+	id := setting(config.ID, "MARKETO_ID", profile.ID)
+	if id == "" {
+		resp.Diagnostics.AddError(
 			"Unable to create client",
-			"Cannot use unknown value as secret",
+			"ID must be set, as MARKETO_ID in the environment or in the credentials file",
 		)
 		return
 	}
 
-	var secret string
-	if config.Secret.Null {
-		secret = os.Getenv("MARKETO_SECRET")
-	} else {
-		secret = config.Secret.Value
-	}
-
+	secret := setting(config.Secret, "MARKETO_SECRET", profile.Secret)
 	if secret == "" {
 		resp.Diagnostics.AddError(
 			"Unable to create client",
-			"Secret cannot be an empty string",
+			"Secret must be set, as MARKETO_SECRET in the environment or in the credentials file",
 		)
 		return
 	}
@@ -131,24 +233,214 @@ func (p *provider) Configure(ctx context.Context, req tfsdk.ConfigureProviderReq
 		return
 	}
 
-	p.client = client
+	if identityEndpoint != "" {
+		client.IdentityURL = strings.TrimSuffix(identityEndpoint, "/")
+	}
+	client.Workspace = config.Workspace.Value
+
+	if !config.RequestTimeout.Null && !config.RequestTimeout.Unknown {
+		client.RequestTimeout, err = time.ParseDuration(config.RequestTimeout.Value)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				tftypes.NewAttributePath().WithAttributeName("request_timeout"),
+				"Invalid request timeout",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	driftPolicy := driftPolicyDiff
+	if !config.DriftPolicy.Null {
+		driftPolicy = config.DriftPolicy.Value
+	}
+
+	if config.Verify.Value {
+		verification, err := client.Verify(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to verify credentials",
+				"Could not authenticate with "+client.IdentityURL+" or probe the API: "+err.Error(),
+			)
+			return
+		}
+
+		if len(verification.Missing) > 0 {
+			resp.Diagnostics.AddError(
+				"Missing API permissions",
+				"The role of API-only user "+strconv.Quote(verification.User)+" lacks the permissions "+strings.Join(verification.Missing, ", ")+". "+
+					"Grant them to the role in Admin > Users & Roles. "+
+					"Write permissions, such as Read-Write Assets, cannot be probed and are only checked when resources are applied.",
+			)
+			return
+		}
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.api = client
+	p.driftPolicy = driftPolicy
 	p.configured = true
+	p.unconfigured = ""
+}
+
+// unknown returns the name of the first setting that is unknown, or an empty
+// string when all of them are known.
+func (config providerData) unknown() string {
+	settings := []struct {
+		name  string
+		value types.String
+	}{
+		{"endpoint", config.Endpoint},
+		{"munchkin_id", config.MunchkinID},
+		{"identity_endpoint", config.IdentityEndpoint},
+		{"profile", config.Profile},
+		{"credentials_file", config.CredentialsFile},
+		{"id", config.ID},
+		{"secret", config.Secret},
+		{"workspace", config.Workspace},
+		{"request_timeout", config.RequestTimeout},
+		{"drift_policy", config.DriftPolicy},
+	}
+	for _, setting := range settings {
+		if setting.value.Unknown {
+			return setting.name
+		}
+	}
+
+	if config.Verify.Unknown {
+		return "verify_credentials"
+	}
+	return ""
+}
+
+// client returns the client of the configured provider.
+func (p *provider) client() *marketo.Client {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.api
+}
+
+// ready reports whether the provider has a client for resources and data
+// sources to use, and adds an error to diags when it does not.
+func (p *provider) ready(diags *diag.Diagnostics) bool {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	if p.configured {
+		return true
+	}
+
+	if p.deferred {
+		diags.AddError(
+			"Provider not configured",
+			"The Marketo API can't be used until apply, because "+p.unconfigured+".",
+		)
+		return false
+	}
+
+	if p.unconfigured != "" {
+		diags.AddError(
+			"Provider not configured",
+			"The Marketo API can't be used, because the provider configuration failed. "+p.unconfigured,
+		)
+		return false
+	}
+
+	diags.AddError(
+		"Provider not configured",
+		"The Marketo API can't be used, because Terraform has not configured the provider. This is a bug in the provider, please report it.",
+	)
+	return false
+}
+
+// refreshable is ready for the Read of a resource. While configuration is
+// deferred it returns false without an error, so that Read keeps the prior
+// state and planning succeeds.
+func (p *provider) refreshable(diags *diag.Diagnostics) bool {
+	p.mu.RLock()
+	deferred := p.deferred
+	p.mu.RUnlock()
+
+	if deferred {
+		return false
+	}
+	return p.ready(diags)
+}
+
+// setting returns the configured value of an attribute, or else the value of
+// the environment variable env, or else the value from the profile.
+func setting(value types.String, env string, profile string) string {
+	if !value.Null {
+		return value.Value
+	}
+	if fromEnv := os.Getenv(env); fromEnv != "" {
+		return fromEnv
+	}
+	return profile
+}
+
+// loadProfile reads the profile named by the configuration or environment
+// from the credentials file. Without either, the default profile of
+// ~/.marketo/credentials is used if there is one.
+func loadProfile(config providerData) (*marketo.Credentials, error) {
+	name := setting(config.Profile, "MARKETO_PROFILE", "")
+	path := setting(config.CredentialsFile, "MARKETO_CREDENTIALS_FILE", "")
+	explicit := name != "" || path != ""
+
+	if name == "" {
+		name = "default"
+	}
+
+	if path == "" {
+		var err error
+		path, err = marketo.DefaultCredentialsFile()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	credentials, err := marketo.LoadCredentials(path, name)
+	if err != nil && !explicit && (os.IsNotExist(err) || errors.Is(err, marketo.ErrProfileNotFound)) {
+		return &marketo.Credentials{}, nil
+	}
+	return credentials, err
 }
 
 func (p *provider) GetResources(_ context.Context) (map[string]tfsdk.ResourceType, diag.Diagnostics) {
 	return map[string]tfsdk.ResourceType{
-		"marketo_program":        resourceProgramType{},
-		"marketo_folder":         resourceFolderType{},
-		"marketo_email":          resourceEmailType{},
-		"marketo_email_template": resourceEmailTemplateType{},
-		"marketo_smart_campaign": resourceSmartCampaignType{},
-		"marketo_smart_list":     resourceSmartListType{},
+		"marketo_program":                   resourceProgramType{},
+		"marketo_folder":                    resourceFolderType{},
+		"marketo_email":                     resourceEmailType{},
+		"marketo_email_template":            resourceEmailTemplateType{},
+		"marketo_smart_campaign":            resourceSmartCampaignType{},
+		"marketo_smart_list":                resourceSmartListType{},
+		"marketo_snippet":                   resourceSnippetType{},
+		"marketo_program_tokens":            resourceProgramTokensType{},
+		"marketo_static_list":               resourceStaticListType{},
+		"marketo_static_list_membership":    resourceStaticListMembershipType{},
+		"marketo_segmentation":              resourceSegmentationType{},
+		"marketo_file":                      resourceFileType{},
+		"marketo_engagement_stream":         resourceEngagementStreamType{},
+		"marketo_engagement_stream_content": resourceEngagementStreamContentType{},
 	}, nil
 }
 
 func (p *provider) GetDataSources(_ context.Context) (map[string]tfsdk.DataSourceType, diag.Diagnostics) {
 	return map[string]tfsdk.DataSourceType{
-		"marketo_channel":    dataSourceChannelType{},
-		"marketo_smart_list": dataSourceSmartListType{},
+		"marketo_channel":         dataSourceChannelType{},
+		"marketo_smart_list":      dataSourceSmartListType{},
+		"marketo_segmentation":    dataSourceSegmentationType{},
+		"marketo_channels":        dataSourceChannelsType{},
+		"marketo_tag_types":       dataSourceTagTypesType{},
+		"marketo_workspace":       dataSourceWorkspaceType{},
+		"marketo_folder":          dataSourceFolderType{},
+		"marketo_program":         dataSourceProgramType{},
+		"marketo_email":           dataSourceEmailType{},
+		"marketo_email_template":  dataSourceEmailTemplateType{},
+		"marketo_smart_campaign":  dataSourceSmartCampaignType{},
+		"marketo_programs":        dataSourceProgramsType{},
+		"marketo_emails":          dataSourceEmailsType{},
+		"marketo_smart_campaigns": dataSourceSmartCampaignsType{},
 	}, nil
 }
@@ -0,0 +1,171 @@
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// setupCredentials points HOME at a temporary directory with a default
+// credentials file, and clears the environment the provider reads.
+func setupCredentials(t *testing.T) string {
+	t.Helper()
+
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	for _, env := range []string{"MARKETO_PROFILE", "MARKETO_CREDENTIALS_FILE", "MARKETO_ID", "MARKETO_SECRET"} {
+		t.Setenv(env, "")
+	}
+
+	writeFile(t, filepath.Join(home, ".marketo", "credentials"), `
+[default]
+id = home-default
+
+[sandbox]
+id = home-sandbox
+`)
+	return home
+}
+
+func writeFile(t *testing.T, path string, content string) {
+	t.Helper()
+
+	err := os.MkdirAll(filepath.Dir(path), 0700)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = os.WriteFile(path, []byte(content), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLoadProfilePrecedence(t *testing.T) {
+	home := setupCredentials(t)
+
+	other := filepath.Join(home, "other")
+	writeFile(t, other, `
+[default]
+id = other-default
+
+[sandbox]
+id = other-sandbox
+`)
+
+	tests := map[string]struct {
+		config providerData
+		env    map[string]string
+		want   string
+	}{
+		"default profile of the default file": {
+			want: "home-default",
+		},
+		"profile from the environment": {
+			env:  map[string]string{"MARKETO_PROFILE": "sandbox"},
+			want: "home-sandbox",
+		},
+		"profile from the configuration over the environment": {
+			config: providerData{Profile: types.String{Value: "default"}},
+			env:    map[string]string{"MARKETO_PROFILE": "sandbox"},
+			want:   "home-default",
+		},
+		"file from the environment": {
+			env:  map[string]string{"MARKETO_CREDENTIALS_FILE": other},
+			want: "other-default",
+		},
+		"file from the configuration over the environment": {
+			config: providerData{CredentialsFile: types.String{Value: other}, Profile: types.String{Value: "sandbox"}},
+			env:    map[string]string{"MARKETO_CREDENTIALS_FILE": filepath.Join(home, "missing")},
+			want:   "other-sandbox",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			for env, value := range test.env {
+				t.Setenv(env, value)
+			}
+
+			config := test.config
+			for _, value := range []*types.String{&config.Profile, &config.CredentialsFile} {
+				if value.Value == "" {
+					value.Null = true
+				}
+			}
+
+			profile, err := loadProfile(config)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if profile.ID != test.want {
+				t.Errorf("got ID %q, want %q", profile.ID, test.want)
+			}
+		})
+	}
+}
+
+func TestLoadProfileMissing(t *testing.T) {
+	home := setupCredentials(t)
+
+	// Without a file or profile being asked for, a missing default is fine.
+	t.Setenv("HOME", t.TempDir())
+	profile, err := loadProfile(providerData{Profile: types.String{Null: true}, CredentialsFile: types.String{Null: true}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if profile.ID != "" {
+		t.Errorf("got ID %q from a missing file", profile.ID)
+	}
+
+	// A profile that was asked for has to exist.
+	t.Setenv("HOME", home)
+	_, err = loadProfile(providerData{Profile: types.String{Value: "production"}, CredentialsFile: types.String{Null: true}})
+	if err == nil {
+		t.Error("expected an error for a missing profile")
+	}
+
+	_, err = loadProfile(providerData{Profile: types.String{Null: true}, CredentialsFile: types.String{Value: filepath.Join(home, "missing")}})
+	if err == nil {
+		t.Error("expected an error for a missing file")
+	}
+}
+
+func TestSettingPrecedence(t *testing.T) {
+	t.Setenv("MARKETO_ID", "env")
+
+	if got := setting(types.String{Value: "config"}, "MARKETO_ID", "profile"); got != "config" {
+		t.Errorf("got %q, want the configured value", got)
+	}
+	if got := setting(types.String{Null: true}, "MARKETO_ID", "profile"); got != "env" {
+		t.Errorf("got %q, want the environment", got)
+	}
+
+	t.Setenv("MARKETO_ID", "")
+	if got := setting(types.String{Null: true}, "MARKETO_ID", "profile"); got != "profile" {
+		t.Errorf("got %q, want the profile", got)
+	}
+}
+
+func TestReadyNamesUnknownSetting(t *testing.T) {
+	config := providerData{Secret: types.String{Unknown: true}}
+	if got := config.unknown(); got != "secret" {
+		t.Fatalf("got unknown setting %q, want secret", got)
+	}
+
+	p := &provider{deferred: true, unconfigured: "the value of secret is not known until apply"}
+	var diags diag.Diagnostics
+	if p.ready(&diags) {
+		t.Fatal("deferred provider is ready")
+	}
+	if detail := diags[0].Detail(); !strings.Contains(detail, "secret") {
+		t.Errorf("error does not name the setting: %s", detail)
+	}
+
+	if p.refreshable(&diags) || len(diags) != 1 {
+		t.Error("deferred provider should not be refreshable, without an error")
+	}
+}
@@ -2,7 +2,9 @@ package provider
 
 import (
 	"context"
-	"time"
+	"errors"
+	"strconv"
+	"strings"
 
 	"github.com/eveld/terraform-provider-marketo/marketo"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -24,6 +26,21 @@ func (r resourceEmailType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diag
 				Type:     types.StringType,
 				Computed: true,
 			},
+			"created_at": {
+				Type:          types.StringType,
+				Computed:      true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{useStateForUnknown{}},
+			},
+			"last_applied": {
+				Type:        types.StringType,
+				Computed:    true,
+				Description: "When Terraform last wrote the email, used to detect changes made in Marketo.",
+			},
+			"content_hash": {
+				Type:        types.StringType,
+				Computed:    true,
+				Description: "Hash of the content of the email as Terraform last wrote it.",
+			},
 			"name": {
 				Type:     types.StringType,
 				Required: true,
@@ -33,12 +50,14 @@ func (r resourceEmailType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diag
 				Optional: true,
 			},
 			"folder": {
-				Type:     types.StringType,
-				Optional: true,
+				Type:          types.StringType,
+				Optional:      true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{tfsdk.RequiresReplace()},
 			},
 			"program": {
-				Type:     types.StringType,
-				Optional: true,
+				Type:          types.StringType,
+				Optional:      true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{tfsdk.RequiresReplace()},
 			},
 			"from_email": {
 				Type:     types.StringType,
@@ -65,8 +84,9 @@ func (r resourceEmailType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diag
 				Required: true,
 			},
 			"template": {
-				Type:     types.StringType,
-				Required: true,
+				Type:          types.StringType,
+				Required:      true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{tfsdk.RequiresReplace()},
 			},
 			"content": {
 				Optional: true,
@@ -89,26 +109,90 @@ func (r resourceEmailType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diag
 					},
 				}, tfsdk.ListNestedAttributesOptions{}),
 			},
+			"timeouts": timeoutsAttribute(),
 		},
 	}, nil
 }
 
 func (r resourceEmailType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
 	return resourceEmail{
-		p: *(p.(*provider)),
+		p: p.(*provider),
 	}, nil
 }
 
 type resourceEmail struct {
-	p provider
+	p *provider
+}
+
+// emailInput turns the plan into the email the client expects. The parent is
+// only used on create.
+func emailInput(plan Email, parent marketo.FolderID) (marketo.Email, error) {
+	email := marketo.Email{
+		Name:        plan.Name.Value,
+		Description: plan.Description.Value,
+		Folder:      parent,
+		Subject:     marketo.EmailHeader{Type: "Text", Value: plan.Subject.Value},
+		FromName:    marketo.EmailHeader{Type: "Text", Value: plan.FromName.Value},
+		FromEmail:   marketo.EmailHeader{Type: "Text", Value: plan.FromEmail.Value},
+		ReplyEmail:  marketo.EmailHeader{Type: "Text", Value: plan.ReplyTo.Value},
+		Operational: plan.Operational.Value,
+		TextOnly:    plan.TextOnly.Value,
+	}
+
+	template, err := strconv.Atoi(plan.Template.Value)
+	if err != nil {
+		return email, errors.New("template must be a numeric ID, got " + strconv.Quote(plan.Template.Value))
+	}
+	email.Template = template
+
+	return email, nil
+}
+
+// emailSections turns the content blocks into the sections the content
+// endpoint expects. Each block sets one of text, dynamic_content or snippet.
+func emailSections(content []EmailContent) []marketo.EmailSection {
+	var sections []marketo.EmailSection
+	for _, c := range content {
+		section := marketo.EmailSection{Section: c.Section.Value, Type: "Text", Value: c.Text.Value}
+		if !c.DynamicContent.Null {
+			section.Type, section.Value = "DynamicContent", c.DynamicContent.Value
+		}
+		if !c.Snippet.Null {
+			section.Type, section.Value = "Snippet", c.Snippet.Value
+		}
+		sections = append(sections, section)
+	}
+	return sections
+}
+
+// emailHash hashes the headers and sections of an email as the API returns
+// them.
+func emailHash(email *marketo.Email, sections []marketo.EmailSection) string {
+	parts := []string{email.Subject.Value, email.FromName.Value, email.FromEmail.Value, email.ReplyEmail.Value}
+	for _, section := range sections {
+		parts = append(parts, section.Section, section.Type, section.Value)
+	}
+	return contentHash(parts...)
+}
+
+// version reads back the updatedAt and content hash of an email after it was
+// written.
+func (r resourceEmail) version(ctx context.Context, emailID string) (string, string, error) {
+	email, err := r.p.client().GetEmail(ctx, emailID)
+	if err != nil {
+		return "", "", err
+	}
+
+	sections, err := r.p.client().GetEmailContent(ctx, emailID)
+	if err != nil {
+		return "", "", err
+	}
+
+	return email.UpdatedAt, emailHash(email, sections), nil
 }
 
 func (r resourceEmail) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
-	if !r.p.configured {
-		resp.Diagnostics.AddError(
-			"Provider not configured",
-			"The provider hasn't been configured before apply, likely because it depends on an unknown value from another resource. This leads to weird stuff happening, so we'd prefer if you didn't do that. Thanks!",
-		)
+	if !r.p.ready(&resp.Diagnostics) {
 		return
 	}
 
@@ -119,11 +203,29 @@ func (r resourceEmail) Create(ctx context.Context, req tfsdk.CreateResourceReque
 		return
 	}
 
-	email := marketo.Email{
-		Name: plan.Name.Value,
+	ctx, cancel := timeoutContext(ctx, plan.Timeouts, "create")
+	defer cancel()
+
+	parent, err := parentFolder(plan.Folder, plan.Program)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid parent",
+			err.Error(),
+		)
+		return
 	}
 
-	result, err := r.p.client.CreateEmail(email)
+	email, err := emailInput(plan, parent)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			tftypes.NewAttributePath().WithAttributeName("template"),
+			"Invalid template",
+			err.Error(),
+		)
+		return
+	}
+
+	result, err := r.p.client().CreateEmail(ctx, email)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating email",
@@ -132,10 +234,47 @@ func (r resourceEmail) Create(ctx context.Context, req tfsdk.CreateResourceReque
 		return
 	}
 
-	// update more fields once they can differ between result and plan.
+	emailID := strconv.Itoa(result.ID)
+	plan.ID = types.String{Value: emailID}
+	plan.CreatedAt = timestamp(result.CreatedAt)
+	if email.TextOnly {
+		// Text only can only be set on existing emails.
+		_, err = r.p.client().UpdateEmail(ctx, emailID, email)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating email",
+				"Could not update email with ID "+emailID+": "+err.Error(),
+			)
+			keepCreated(ctx, resp, plan)
+			return
+		}
+	}
+
+	for _, section := range emailSections(plan.Content) {
+		err = r.p.client().UpdateEmailSection(ctx, emailID, section)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating email",
+				"Could not set section "+section.Section+" of email with ID "+emailID+": "+err.Error(),
+			)
+			keepCreated(ctx, resp, plan)
+			return
+		}
+	}
+
+	updatedAt, hash, err := r.version(ctx, emailID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating email",
+			"Could not read back email with ID "+emailID+": "+err.Error(),
+		)
+		keepCreated(ctx, resp, plan)
+		return
+	}
 
-	plan.ID = types.String{Value: result.ID}
-	plan.LastUpdated = types.String{Value: string(time.Now().Format(time.RFC850))}
+	plan.LastUpdated = timestamp(updatedAt)
+	plan.LastApplied = plan.LastUpdated
+	plan.ContentHash = types.String{Value: hash}
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -145,6 +284,10 @@ func (r resourceEmail) Create(ctx context.Context, req tfsdk.CreateResourceReque
 }
 
 func (r resourceEmail) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	if !r.p.refreshable(&resp.Diagnostics) {
+		return
+	}
+
 	var state Email
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -152,8 +295,14 @@ func (r resourceEmail) Read(ctx context.Context, req tfsdk.ReadResourceRequest,
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, state.Timeouts, "read")
+	defer cancel()
+
+	// Imported emails only have an ID.
+	imported := state.Name.Null
+
 	emailID := state.ID.Value
-	email, err := r.p.client.GetEmail(emailID)
+	email, err := r.p.client().GetEmail(ctx, emailID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading email",
@@ -162,8 +311,70 @@ func (r resourceEmail) Read(ctx context.Context, req tfsdk.ReadResourceRequest,
 		return
 	}
 
-	state.ID = types.String{Value: email.ID}
+	sections, err := r.p.client().GetEmailContent(ctx, emailID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading email",
+			"Could not read content of email with ID "+emailID+": "+err.Error(),
+		)
+		return
+	}
+
+	hash := emailHash(email, sections)
+	if r.p.keepOnDrift(&resp.Diagnostics, "email", emailID, state.LastApplied, state.ContentHash, email.UpdatedAt, hash) {
+		state.LastUpdated = timestamp(email.UpdatedAt)
+		diags = resp.State.Set(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	state.ID = types.String{Value: strconv.Itoa(email.ID)}
 	state.Name = types.String{Value: email.Name}
+	state.CreatedAt = timestamp(email.CreatedAt)
+	state.LastUpdated = timestamp(email.UpdatedAt)
+	if !state.Description.Null || email.Description != "" {
+		state.Description = types.String{Value: email.Description}
+	}
+	setParent(email.Folder, &state.Folder, &state.Program)
+	state.Subject = types.String{Value: email.Subject.Value}
+	state.FromName = types.String{Value: email.FromName.Value}
+	state.FromEmail = types.String{Value: email.FromEmail.Value}
+	state.ReplyTo = types.String{Value: email.ReplyEmail.Value}
+	if !state.Operational.Null || email.Operational {
+		state.Operational = types.Bool{Value: email.Operational}
+	}
+	if !state.TextOnly.Null || email.TextOnly {
+		state.TextOnly = types.Bool{Value: email.TextOnly}
+	}
+	state.Template = types.String{Value: strconv.Itoa(email.Template)}
+
+	// Imported emails take over every section. Otherwise only sections that
+	// are managed here are refreshed, the template defines the others.
+	if imported {
+		state.Content = emailContent(sections)
+	}
+	for i, content := range state.Content {
+		for _, section := range sections {
+			if section.Section != content.Section.Value {
+				continue
+			}
+			switch {
+			case !content.Snippet.Null:
+				state.Content[i].Snippet = types.String{Value: section.Value}
+			case !content.DynamicContent.Null:
+				state.Content[i].DynamicContent = types.String{Value: section.Value}
+			default:
+				state.Content[i].Text = types.String{Value: section.Value}
+			}
+		}
+	}
+
+	// Imported emails and state written before drift was tracked take the
+	// current version as the one Terraform last wrote.
+	if state.LastApplied.Null {
+		state.LastApplied = state.LastUpdated
+		state.ContentHash = types.String{Value: hash}
+	}
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -173,6 +384,10 @@ func (r resourceEmail) Read(ctx context.Context, req tfsdk.ReadResourceRequest,
 }
 
 func (r resourceEmail) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	if !r.p.ready(&resp.Diagnostics) {
+		return
+	}
+
 	var plan Email
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -180,6 +395,9 @@ func (r resourceEmail) Update(ctx context.Context, req tfsdk.UpdateResourceReque
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, plan.Timeouts, "update")
+	defer cancel()
+
 	var state Email
 	diags = req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -187,24 +405,60 @@ func (r resourceEmail) Update(ctx context.Context, req tfsdk.UpdateResourceReque
 		return
 	}
 
-	email := marketo.Email{
-		Name: plan.Name.Value,
+	email, err := emailInput(plan, marketo.FolderID{})
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			tftypes.NewAttributePath().WithAttributeName("template"),
+			"Invalid template",
+			err.Error(),
+		)
+		return
 	}
 
 	emailID := state.ID.Value
-	result, err := r.p.client.UpdateEmail(emailID, email)
+	result, err := r.p.client().UpdateEmail(ctx, emailID, email)
 	if err != nil {
 		resp.Diagnostics.AddError(
-			"Error update order",
-			"Could not update orderID "+emailID+": "+err.Error(),
+			"Error updating email",
+			"Could not update email with ID "+emailID+": "+err.Error(),
 		)
 		return
 	}
 
-	// update more fields once they can differ between result and plan.
+	err = r.p.client().UpdateEmailHeaders(ctx, emailID, email)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating email",
+			"Could not update headers of email with ID "+emailID+": "+err.Error(),
+		)
+		return
+	}
+
+	for _, section := range emailSections(plan.Content) {
+		err = r.p.client().UpdateEmailSection(ctx, emailID, section)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating email",
+				"Could not set section "+section.Section+" of email with ID "+emailID+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	updatedAt, hash, err := r.version(ctx, emailID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating email",
+			"Could not read back email with ID "+emailID+": "+err.Error(),
+		)
+		return
+	}
 
-	plan.ID = types.String{Value: result.ID}
-	plan.LastUpdated = types.String{Value: string(time.Now().Format(time.RFC850))}
+	plan.ID = types.String{Value: strconv.Itoa(result.ID)}
+	plan.CreatedAt = timestamp(result.CreatedAt)
+	plan.LastUpdated = timestamp(updatedAt)
+	plan.LastApplied = plan.LastUpdated
+	plan.ContentHash = types.String{Value: hash}
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -214,6 +468,10 @@ func (r resourceEmail) Update(ctx context.Context, req tfsdk.UpdateResourceReque
 }
 
 func (r resourceEmail) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	if !r.p.ready(&resp.Diagnostics) {
+		return
+	}
+
 	var state Email
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -221,8 +479,11 @@ func (r resourceEmail) Delete(ctx context.Context, req tfsdk.DeleteResourceReque
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, state.Timeouts, "delete")
+	defer cancel()
+
 	emailID := state.ID.Value
-	err := r.p.client.DeleteEmail(emailID)
+	err := r.p.client().DeleteEmail(ctx, emailID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting email",
@@ -234,6 +495,28 @@ func (r resourceEmail) Delete(ctx context.Context, req tfsdk.DeleteResourceReque
 	resp.State.RemoveResource(ctx)
 }
 
+// ImportState accepts the ID of the email or "email:<program>/<name>" with
+// the ID of the program the email is in.
 func (r resourceEmail) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
-	tfsdk.ResourceImportStatePassthroughID(ctx, tftypes.NewAttributePath().WithAttributeName("id"), req, resp)
+	if !r.p.ready(&resp.Diagnostics) {
+		return
+	}
+
+	importByName(ctx, req, resp, "email", func(name string) (int, error) {
+		parts := strings.SplitN(name, "/", 2)
+		if len(parts) != 2 {
+			return 0, errors.New("expected <program>/<name>")
+		}
+
+		programID, err := strconv.Atoi(parts[0])
+		if err != nil {
+			return 0, errors.New("program must be a numeric ID, got " + strconv.Quote(parts[0]))
+		}
+
+		email, err := r.p.client().GetEmailByName(ctx, parts[1], &marketo.FolderID{ID: programID, Type: "Program"})
+		if err != nil {
+			return 0, err
+		}
+		return email.ID, nil
+	})
 }
@@ -2,7 +2,7 @@ package provider
 
 import (
 	"context"
-	"time"
+	"strconv"
 
 	"github.com/eveld/terraform-provider-marketo/marketo"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -24,6 +24,21 @@ func (r resourceEmailTemplateType) GetSchema(_ context.Context) (tfsdk.Schema, d
 				Type:     types.StringType,
 				Computed: true,
 			},
+			"created_at": {
+				Type:          types.StringType,
+				Computed:      true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{useStateForUnknown{}},
+			},
+			"last_applied": {
+				Type:        types.StringType,
+				Computed:    true,
+				Description: "When Terraform last wrote the email template, used to detect changes made in Marketo.",
+			},
+			"content_hash": {
+				Type:        types.StringType,
+				Computed:    true,
+				Description: "Hash of the content of the email template as Terraform last wrote it.",
+			},
 			"name": {
 				Type:     types.StringType,
 				Required: true,
@@ -33,37 +48,52 @@ func (r resourceEmailTemplateType) GetSchema(_ context.Context) (tfsdk.Schema, d
 				Optional: true,
 			},
 			"folder": {
-				Type:     types.StringType,
-				Optional: true,
+				Type:          types.StringType,
+				Optional:      true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{tfsdk.RequiresReplace()},
 			},
 			"program": {
-				Type:     types.StringType,
-				Optional: true,
+				Type:          types.StringType,
+				Optional:      true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{tfsdk.RequiresReplace()},
 			},
 			"content": {
 				Type:     types.StringType,
 				Required: true,
 			},
+			"timeouts": timeoutsAttribute(),
 		},
 	}, nil
 }
 
 func (r resourceEmailTemplateType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
 	return resourceEmailTemplate{
-		p: *(p.(*provider)),
+		p: p.(*provider),
 	}, nil
 }
 
 type resourceEmailTemplate struct {
-	p provider
+	p *provider
+}
+
+// version reads back the updatedAt and content hash of a template after it
+// was written.
+func (r resourceEmailTemplate) version(ctx context.Context, emailTemplateID string) (string, string, error) {
+	emailTemplate, err := r.p.client().GetEmailTemplate(ctx, emailTemplateID)
+	if err != nil {
+		return "", "", err
+	}
+
+	content, err := r.p.client().GetEmailTemplateContent(ctx, emailTemplateID)
+	if err != nil {
+		return "", "", err
+	}
+
+	return emailTemplate.UpdatedAt, contentHash(content), nil
 }
 
 func (r resourceEmailTemplate) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
-	if !r.p.configured {
-		resp.Diagnostics.AddError(
-			"Provider not configured",
-			"The provider hasn't been configured before apply, likely because it depends on an unknown value from another resource. This leads to weird stuff happening, so we'd prefer if you didn't do that. Thanks!",
-		)
+	if !r.p.ready(&resp.Diagnostics) {
 		return
 	}
 
@@ -74,23 +104,49 @@ func (r resourceEmailTemplate) Create(ctx context.Context, req tfsdk.CreateResou
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, plan.Timeouts, "create")
+	defer cancel()
+
+	parent, err := parentFolder(plan.Folder, plan.Program)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid parent",
+			err.Error(),
+		)
+		return
+	}
+
 	emailTemplate := marketo.EmailTemplate{
-		Name: plan.Name.Value,
+		Name:        plan.Name.Value,
+		Description: plan.Description.Value,
+		Folder:      parent,
 	}
 
-	result, err := r.p.client.CreateEmailTemplate(emailTemplate)
+	result, err := r.p.client().CreateEmailTemplate(ctx, emailTemplate, plan.Content.Value)
 	if err != nil {
 		resp.Diagnostics.AddError(
-			"Error creating emailTemplate",
-			"Could not create emailTemplate, unexpected error: "+err.Error(),
+			"Error creating email template",
+			"Could not create email template, unexpected error: "+err.Error(),
 		)
 		return
 	}
 
-	// update more fields once they can differ between result and plan.
+	emailTemplateID := strconv.Itoa(result.ID)
+	plan.ID = types.String{Value: emailTemplateID}
+	plan.CreatedAt = timestamp(result.CreatedAt)
+	updatedAt, hash, err := r.version(ctx, emailTemplateID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating email template",
+			"Could not read back email template with ID "+emailTemplateID+": "+err.Error(),
+		)
+		keepCreated(ctx, resp, plan)
+		return
+	}
 
-	plan.ID = types.String{Value: result.ID}
-	plan.LastUpdated = types.String{Value: string(time.Now().Format(time.RFC850))}
+	plan.LastUpdated = timestamp(updatedAt)
+	plan.LastApplied = plan.LastUpdated
+	plan.ContentHash = types.String{Value: hash}
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -100,6 +156,10 @@ func (r resourceEmailTemplate) Create(ctx context.Context, req tfsdk.CreateResou
 }
 
 func (r resourceEmailTemplate) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	if !r.p.refreshable(&resp.Diagnostics) {
+		return
+	}
+
 	var state EmailTemplate
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -107,18 +167,52 @@ func (r resourceEmailTemplate) Read(ctx context.Context, req tfsdk.ReadResourceR
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, state.Timeouts, "read")
+	defer cancel()
+
 	emailTemplateID := state.ID.Value
-	emailTemplate, err := r.p.client.GetEmailTemplate(emailTemplateID)
+	emailTemplate, err := r.p.client().GetEmailTemplate(ctx, emailTemplateID)
 	if err != nil {
 		resp.Diagnostics.AddError(
-			"Error reading emailTemplate",
-			"Could not read emailTemplate with ID "+emailTemplateID+": "+err.Error(),
+			"Error reading email template",
+			"Could not read email template with ID "+emailTemplateID+": "+err.Error(),
 		)
 		return
 	}
 
-	state.ID = types.String{Value: emailTemplate.ID}
+	content, err := r.p.client().GetEmailTemplateContent(ctx, emailTemplateID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading email template",
+			"Could not read content of email template with ID "+emailTemplateID+": "+err.Error(),
+		)
+		return
+	}
+
+	hash := contentHash(content)
+	if r.p.keepOnDrift(&resp.Diagnostics, "email template", emailTemplateID, state.LastApplied, state.ContentHash, emailTemplate.UpdatedAt, hash) {
+		state.LastUpdated = timestamp(emailTemplate.UpdatedAt)
+		diags = resp.State.Set(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	state.ID = types.String{Value: strconv.Itoa(emailTemplate.ID)}
 	state.Name = types.String{Value: emailTemplate.Name}
+	state.CreatedAt = timestamp(emailTemplate.CreatedAt)
+	state.LastUpdated = timestamp(emailTemplate.UpdatedAt)
+	if !state.Description.Null || emailTemplate.Description != "" {
+		state.Description = types.String{Value: emailTemplate.Description}
+	}
+	setParent(emailTemplate.Folder, &state.Folder, &state.Program)
+	state.Content = types.String{Value: content}
+
+	// Imported email templates and state written before drift was tracked
+	// take the current version as the one Terraform last wrote.
+	if state.LastApplied.Null {
+		state.LastApplied = state.LastUpdated
+		state.ContentHash = types.String{Value: hash}
+	}
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -128,6 +222,10 @@ func (r resourceEmailTemplate) Read(ctx context.Context, req tfsdk.ReadResourceR
 }
 
 func (r resourceEmailTemplate) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	if !r.p.ready(&resp.Diagnostics) {
+		return
+	}
+
 	var plan EmailTemplate
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -135,6 +233,9 @@ func (r resourceEmailTemplate) Update(ctx context.Context, req tfsdk.UpdateResou
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, plan.Timeouts, "update")
+	defer cancel()
+
 	var state EmailTemplate
 	diags = req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -143,23 +244,45 @@ func (r resourceEmailTemplate) Update(ctx context.Context, req tfsdk.UpdateResou
 	}
 
 	emailTemplate := marketo.EmailTemplate{
-		Name: plan.Name.Value,
+		Name:        plan.Name.Value,
+		Description: plan.Description.Value,
 	}
 
 	emailTemplateID := state.ID.Value
-	result, err := r.p.client.UpdateEmailTemplate(emailTemplateID, emailTemplate)
+	result, err := r.p.client().UpdateEmailTemplate(ctx, emailTemplateID, emailTemplate)
 	if err != nil {
 		resp.Diagnostics.AddError(
-			"Error update order",
-			"Could not update orderID "+emailTemplateID+": "+err.Error(),
+			"Error updating email template",
+			"Could not update email template with ID "+emailTemplateID+": "+err.Error(),
 		)
 		return
 	}
 
-	// update more fields once they can differ between result and plan.
+	if plan.Content.Value != state.Content.Value {
+		err = r.p.client().UpdateEmailTemplateContent(ctx, emailTemplateID, plan.Name.Value, plan.Content.Value)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating email template",
+				"Could not update content of email template with ID "+emailTemplateID+": "+err.Error(),
+			)
+			return
+		}
+	}
 
-	plan.ID = types.String{Value: result.ID}
-	plan.LastUpdated = types.String{Value: string(time.Now().Format(time.RFC850))}
+	updatedAt, hash, err := r.version(ctx, emailTemplateID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating email template",
+			"Could not read back email template with ID "+emailTemplateID+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.String{Value: strconv.Itoa(result.ID)}
+	plan.CreatedAt = timestamp(result.CreatedAt)
+	plan.LastUpdated = timestamp(updatedAt)
+	plan.LastApplied = plan.LastUpdated
+	plan.ContentHash = types.String{Value: hash}
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -169,6 +292,10 @@ func (r resourceEmailTemplate) Update(ctx context.Context, req tfsdk.UpdateResou
 }
 
 func (r resourceEmailTemplate) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	if !r.p.ready(&resp.Diagnostics) {
+		return
+	}
+
 	var state EmailTemplate
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -176,8 +303,11 @@ func (r resourceEmailTemplate) Delete(ctx context.Context, req tfsdk.DeleteResou
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, state.Timeouts, "delete")
+	defer cancel()
+
 	emailTemplateID := state.ID.Value
-	err := r.p.client.DeleteEmailTemplate(emailTemplateID)
+	err := r.p.client().DeleteEmailTemplate(ctx, emailTemplateID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting emailTemplate",
@@ -0,0 +1,265 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/eveld/terraform-provider-marketo/marketo"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type resourceEngagementStreamType struct{}
+
+func (r resourceEngagementStreamType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"last_updated": {
+				Type:        types.StringType,
+				Computed:    true,
+				Description: "When the engagement program holding the stream was last updated. Streams have no timestamps of their own, so this changes with any change to the program, not only to this stream.",
+			},
+			"created_at": {
+				Type:          types.StringType,
+				Computed:      true,
+				Description:   "When the engagement program holding the stream was created. Streams have no timestamps of their own.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{useStateForUnknown{}},
+			},
+			"program": {
+				Type:          types.StringType,
+				Required:      true,
+				Description:   "ID of the engagement program the stream belongs to.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{tfsdk.RequiresReplace()},
+			},
+			"name": {
+				Type:     types.StringType,
+				Required: true,
+			},
+			"cadence": {
+				Required: true,
+				Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+					"day_of_week": {
+						Type:     types.StringType,
+						Required: true,
+						Validators: []tfsdk.AttributeValidator{
+							stringOneOf{"Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday", "Sunday"},
+						},
+					},
+					"time": {
+						Type:        types.StringType,
+						Required:    true,
+						Description: "Time of day to cast, as HH:MM.",
+					},
+					"recurrence": {
+						Type:     types.StringType,
+						Required: true,
+						Validators: []tfsdk.AttributeValidator{
+							stringOneOf{"daily", "weekly", "biweekly", "monthly"},
+						},
+					},
+				}),
+			},
+			"timeouts": timeoutsAttribute(),
+		},
+	}, nil
+}
+
+func (r resourceEngagementStreamType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return resourceEngagementStream{
+		p: p.(*provider),
+	}, nil
+}
+
+type resourceEngagementStream struct {
+	p *provider
+}
+
+func streamFromPlan(plan EngagementStream) marketo.Stream {
+	return marketo.Stream{
+		Name: plan.Name.Value,
+		Cadence: marketo.Cadence{
+			DayOfWeek:  plan.Cadence.DayOfWeek.Value,
+			Time:       plan.Cadence.Time.Value,
+			Recurrence: plan.Cadence.Recurrence.Value,
+		},
+	}
+}
+
+func (r resourceEngagementStream) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	if !r.p.ready(&resp.Diagnostics) {
+		return
+	}
+
+	var plan EngagementStream
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, plan.Timeouts, "create")
+	defer cancel()
+
+	programID := plan.Program.Value
+	result, err := r.p.client().CreateStream(ctx, programID, streamFromPlan(plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating engagement stream",
+			"Could not create stream in program with ID "+programID+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.String{Value: strconv.Itoa(result.ID)}
+	plan.CreatedAt, plan.LastUpdated, err = programTimestamps(ctx, r.p.client(), programID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating engagement stream",
+			"Could not read program with ID "+plan.Program.Value+": "+err.Error(),
+		)
+		keepCreated(ctx, resp, plan)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r resourceEngagementStream) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	if !r.p.refreshable(&resp.Diagnostics) {
+		return
+	}
+
+	var state EngagementStream
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, state.Timeouts, "read")
+	defer cancel()
+
+	streamID := state.ID.Value
+	stream, err := r.p.client().GetStream(ctx, state.Program.Value, streamID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading engagement stream",
+			"Could not read stream with ID "+streamID+": "+err.Error(),
+		)
+		return
+	}
+
+	state.ID = types.String{Value: strconv.Itoa(stream.ID)}
+	state.Name = types.String{Value: stream.Name}
+	state.CreatedAt, state.LastUpdated, err = programTimestamps(ctx, r.p.client(), state.Program.Value)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading engagement stream",
+			"Could not read program with ID "+state.Program.Value+": "+err.Error(),
+		)
+		return
+	}
+	state.Cadence = Cadence{
+		DayOfWeek:  types.String{Value: stream.Cadence.DayOfWeek},
+		Time:       types.String{Value: stream.Cadence.Time},
+		Recurrence: types.String{Value: stream.Cadence.Recurrence},
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r resourceEngagementStream) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	if !r.p.ready(&resp.Diagnostics) {
+		return
+	}
+
+	var plan EngagementStream
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, plan.Timeouts, "update")
+	defer cancel()
+
+	var state EngagementStream
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	streamID := state.ID.Value
+	result, err := r.p.client().UpdateStream(ctx, state.Program.Value, streamID, streamFromPlan(plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating engagement stream",
+			"Could not update stream with ID "+streamID+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.String{Value: strconv.Itoa(result.ID)}
+	plan.CreatedAt, plan.LastUpdated, err = programTimestamps(ctx, r.p.client(), state.Program.Value)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating engagement stream",
+			"Could not read program with ID "+state.Program.Value+": "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r resourceEngagementStream) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	if !r.p.ready(&resp.Diagnostics) {
+		return
+	}
+
+	var state EngagementStream
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, state.Timeouts, "delete")
+	defer cancel()
+
+	streamID := state.ID.Value
+	err := r.p.client().DeleteStream(ctx, state.Program.Value, streamID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting engagement stream",
+			"Could not delete stream with ID "+streamID+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState expects "<program>/<stream>", as streams are addressed through
+// their program.
+func (r resourceEngagementStream) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	importCompositeID(ctx, req, resp, "program", "id")
+}
@@ -0,0 +1,291 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/eveld/terraform-provider-marketo/marketo"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type resourceEngagementStreamContentType struct{}
+
+func (r resourceEngagementStreamContentType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: "Manages the ordered content of an engagement stream. Content that is not declared is removed from the stream.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"last_updated": {
+				Type:        types.StringType,
+				Computed:    true,
+				Description: "When the engagement program holding the stream was last updated. Streams have no timestamps of their own, so this changes with any change to the program, not only to this stream.",
+			},
+			"created_at": {
+				Type:          types.StringType,
+				Computed:      true,
+				Description:   "When the engagement program holding the stream was created. Streams have no timestamps of their own.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{useStateForUnknown{}},
+			},
+			"program": {
+				Type:          types.StringType,
+				Required:      true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{tfsdk.RequiresReplace()},
+			},
+			"stream": {
+				Type:          types.StringType,
+				Required:      true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{tfsdk.RequiresReplace()},
+			},
+			"content": {
+				Required:    true,
+				Description: "Emails and programs in the order they are cast.",
+				Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
+					"type": {
+						Type:     types.StringType,
+						Required: true,
+						Validators: []tfsdk.AttributeValidator{
+							stringOneOf{"email", "program"},
+						},
+					},
+					"id": {
+						Type:     types.StringType,
+						Required: true,
+					},
+					"active": {
+						Type:     types.BoolType,
+						Required: true,
+					},
+				}, tfsdk.ListNestedAttributesOptions{}),
+			},
+			"timeouts": timeoutsAttribute(),
+		},
+	}, nil
+}
+
+func (r resourceEngagementStreamContentType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return resourceEngagementStreamContent{
+		p: p.(*provider),
+	}, nil
+}
+
+type resourceEngagementStreamContent struct {
+	p *provider
+}
+
+func streamContentFromPlan(plan EngagementStreamContent) ([]marketo.StreamContent, error) {
+	content := make([]marketo.StreamContent, 0, len(plan.Content))
+	for _, c := range plan.Content {
+		id, err := strconv.Atoi(c.ID.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		content = append(content, marketo.StreamContent{
+			Type:   c.Type.Value,
+			ID:     id,
+			Active: c.Active.Value,
+		})
+	}
+	return content, nil
+}
+
+func (r resourceEngagementStreamContent) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	if !r.p.ready(&resp.Diagnostics) {
+		return
+	}
+
+	var plan EngagementStreamContent
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, plan.Timeouts, "create")
+	defer cancel()
+
+	content, err := streamContentFromPlan(plan)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			tftypes.NewAttributePath().WithAttributeName("content"),
+			"Invalid content",
+			"Content IDs must be numeric: "+err.Error(),
+		)
+		return
+	}
+
+	streamID := plan.Stream.Value
+	err = r.p.client().UpdateStreamContent(ctx, plan.Program.Value, streamID, content)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating engagement stream content",
+			"Could not set content of stream with ID "+streamID+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.String{Value: streamID}
+	plan.CreatedAt, plan.LastUpdated, err = programTimestamps(ctx, r.p.client(), plan.Program.Value)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating engagement stream content",
+			"Could not read program with ID "+plan.Program.Value+": "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r resourceEngagementStreamContent) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	if !r.p.refreshable(&resp.Diagnostics) {
+		return
+	}
+
+	var state EngagementStreamContent
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, state.Timeouts, "read")
+	defer cancel()
+
+	streamID := state.ID.Value
+	content, err := r.p.client().GetStreamContent(ctx, state.Program.Value, streamID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading engagement stream content",
+			"Could not read content of stream with ID "+streamID+": "+err.Error(),
+		)
+		return
+	}
+
+	state.Stream = types.String{Value: streamID}
+	state.CreatedAt, state.LastUpdated, err = programTimestamps(ctx, r.p.client(), state.Program.Value)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading engagement stream content",
+			"Could not read program with ID "+state.Program.Value+": "+err.Error(),
+		)
+		return
+	}
+	state.Content = make([]StreamContent, 0, len(content))
+	for _, c := range content {
+		state.Content = append(state.Content, StreamContent{
+			Type:   types.String{Value: c.Type},
+			ID:     types.String{Value: strconv.Itoa(c.ID)},
+			Active: types.Bool{Value: c.Active},
+		})
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r resourceEngagementStreamContent) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	if !r.p.ready(&resp.Diagnostics) {
+		return
+	}
+
+	var plan EngagementStreamContent
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, plan.Timeouts, "update")
+	defer cancel()
+
+	var state EngagementStreamContent
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	content, err := streamContentFromPlan(plan)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			tftypes.NewAttributePath().WithAttributeName("content"),
+			"Invalid content",
+			"Content IDs must be numeric: "+err.Error(),
+		)
+		return
+	}
+
+	streamID := state.ID.Value
+	err = r.p.client().UpdateStreamContent(ctx, state.Program.Value, streamID, content)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating engagement stream content",
+			"Could not update content of stream with ID "+streamID+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = state.ID
+	plan.CreatedAt, plan.LastUpdated, err = programTimestamps(ctx, r.p.client(), state.Program.Value)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating engagement stream content",
+			"Could not read program with ID "+state.Program.Value+": "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r resourceEngagementStreamContent) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	if !r.p.ready(&resp.Diagnostics) {
+		return
+	}
+
+	var state EngagementStreamContent
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, state.Timeouts, "delete")
+	defer cancel()
+
+	streamID := state.ID.Value
+	err := r.p.client().UpdateStreamContent(ctx, state.Program.Value, streamID, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting engagement stream content",
+			"Could not clear content of stream with ID "+streamID+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState expects "<program>/<stream>", as streams are addressed through
+// their program.
+func (r resourceEngagementStreamContent) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	importCompositeID(ctx, req, resp, "program", "id")
+}
@@ -0,0 +1,354 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/eveld/terraform-provider-marketo/marketo"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type resourceFileType struct{}
+
+func (r resourceFileType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"last_updated": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"created_at": {
+				Type:          types.StringType,
+				Computed:      true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{useStateForUnknown{}},
+			},
+			"name": {
+				Type:          types.StringType,
+				Required:      true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{tfsdk.RequiresReplace()},
+			},
+			"description": {
+				Type:          types.StringType,
+				Optional:      true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{tfsdk.RequiresReplace()},
+			},
+			"folder": {
+				Type:          types.StringType,
+				Required:      true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{tfsdk.RequiresReplace()},
+			},
+			"source": {
+				Type:        types.StringType,
+				Required:    true,
+				Description: "Path to the local file to upload.",
+			},
+			"source_hash": {
+				Type:        types.StringType,
+				Computed:    true,
+				Description: "SHA256 of the uploaded file. The content is replaced when the local file changes.",
+			},
+			"insert_only": {
+				Type:        types.BoolType,
+				Optional:    true,
+				Description: "Fail instead of replacing an existing file with the same name.",
+			},
+			"url": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"mime_type": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"size": {
+				Type:     types.Int64Type,
+				Computed: true,
+			},
+			"timeouts": timeoutsAttribute(),
+		},
+	}, nil
+}
+
+func (r resourceFileType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return resourceFile{
+		p: p.(*provider),
+	}, nil
+}
+
+type resourceFile struct {
+	p *provider
+}
+
+func readSource(path string) ([]byte, string, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, "", err
+	}
+
+	sum := sha256.Sum256(content)
+	return content, hex.EncodeToString(sum[:]), nil
+}
+
+// ModifyPlan hashes the local file so that a change in content, rather than
+// only in path, results in an update.
+func (r resourceFile) ModifyPlan(ctx context.Context, req tfsdk.ModifyResourcePlanRequest, resp *tfsdk.ModifyResourcePlanResponse) {
+	sourcePath := tftypes.NewAttributePath().WithAttributeName("source")
+	value, diags := req.Plan.GetAttribute(ctx, sourcePath)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	source, ok := value.(types.String)
+	if !ok || source.Null || source.Unknown {
+		return
+	}
+
+	_, hash, err := readSource(source.Value)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			sourcePath,
+			"Unable to read source",
+			"Could not read "+source.Value+": "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.Plan.SetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("source_hash"), types.String{Value: hash})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || req.State.Raw.IsNull() {
+		return
+	}
+
+	value, diags = req.State.GetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("source_hash"))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stateHash, ok := value.(types.String)
+	if ok && stateHash.Value == hash {
+		return
+	}
+
+	// Update uploads the new content, which changes what the API reports
+	// about the file. Without a change of path the framework leaves these
+	// at their prior values.
+	for name, value := range map[string]attr.Value{
+		"url":          types.String{Unknown: true},
+		"mime_type":    types.String{Unknown: true},
+		"size":         types.Int64{Unknown: true},
+		"last_updated": types.String{Unknown: true},
+	} {
+		diags = resp.Plan.SetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName(name), value)
+		resp.Diagnostics.Append(diags...)
+	}
+}
+
+func (r resourceFile) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	if !r.p.ready(&resp.Diagnostics) {
+		return
+	}
+
+	var plan File
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, plan.Timeouts, "create")
+	defer cancel()
+
+	folder, err := parentFolder(plan.Folder, types.String{Null: true})
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			tftypes.NewAttributePath().WithAttributeName("folder"),
+			"Invalid folder",
+			err.Error(),
+		)
+		return
+	}
+
+	content, hash, err := readSource(plan.Source.Value)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating file",
+			"Could not read "+plan.Source.Value+": "+err.Error(),
+		)
+		return
+	}
+
+	file := marketo.File{
+		Name:        plan.Name.Value,
+		Description: plan.Description.Value,
+		Folder:      folder,
+	}
+
+	result, err := r.p.client().CreateFile(ctx, file, filepath.Base(plan.Source.Value), content, plan.InsertOnly.Value)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating file",
+			"Could not create file, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.String{Value: strconv.Itoa(result.ID)}
+	plan.SourceHash = types.String{Value: hash}
+	plan.URL = types.String{Value: result.URL}
+	plan.MimeType = types.String{Value: result.MimeType}
+	plan.Size = types.Int64{Value: int64(result.Size)}
+	plan.CreatedAt = timestamp(result.CreatedAt)
+	plan.LastUpdated = timestamp(result.UpdatedAt)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r resourceFile) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	if !r.p.refreshable(&resp.Diagnostics) {
+		return
+	}
+
+	var state File
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, state.Timeouts, "read")
+	defer cancel()
+
+	fileID := state.ID.Value
+	file, err := r.p.client().GetFile(ctx, fileID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading file",
+			"Could not read file with ID "+fileID+": "+err.Error(),
+		)
+		return
+	}
+
+	state.ID = types.String{Value: strconv.Itoa(file.ID)}
+	state.Name = types.String{Value: file.Name}
+	state.CreatedAt = timestamp(file.CreatedAt)
+	state.LastUpdated = timestamp(file.UpdatedAt)
+	state.Folder = types.String{Value: strconv.Itoa(file.Folder.ID)}
+	state.URL = types.String{Value: file.URL}
+	state.MimeType = types.String{Value: file.MimeType}
+	state.Size = types.Int64{Value: int64(file.Size)}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r resourceFile) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	if !r.p.ready(&resp.Diagnostics) {
+		return
+	}
+
+	var plan File
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, plan.Timeouts, "update")
+	defer cancel()
+
+	var state File
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = state.ID
+	plan.URL = state.URL
+	plan.MimeType = state.MimeType
+	plan.Size = state.Size
+	plan.CreatedAt = state.CreatedAt
+	plan.LastUpdated = state.LastUpdated
+
+	fileID := state.ID.Value
+	content, hash, err := readSource(plan.Source.Value)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating file",
+			"Could not read "+plan.Source.Value+": "+err.Error(),
+		)
+		return
+	}
+
+	if hash != state.SourceHash.Value {
+		result, err := r.p.client().UpdateFileContent(ctx, fileID, filepath.Base(plan.Source.Value), content)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating file",
+				"Could not replace content of file with ID "+fileID+": "+err.Error(),
+			)
+			return
+		}
+
+		plan.URL = types.String{Value: result.URL}
+		plan.MimeType = types.String{Value: result.MimeType}
+		plan.Size = types.Int64{Value: int64(result.Size)}
+		plan.LastUpdated = timestamp(result.UpdatedAt)
+	}
+
+	plan.SourceHash = types.String{Value: hash}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r resourceFile) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	if !r.p.ready(&resp.Diagnostics) {
+		return
+	}
+
+	var state File
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, state.Timeouts, "delete")
+	defer cancel()
+
+	resp.Diagnostics.AddWarning(
+		"File not deleted",
+		"The Marketo API cannot delete files, file with ID "+state.ID.Value+" is only removed from the Terraform state and remains in Design Studio.",
+	)
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r resourceFile) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	tfsdk.ResourceImportStatePassthroughID(ctx, tftypes.NewAttributePath().WithAttributeName("id"), req, resp)
+}
@@ -2,13 +2,13 @@ package provider
 
 import (
 	"context"
-	"time"
+	"errors"
+	"strconv"
 
 	"github.com/eveld/terraform-provider-marketo/marketo"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 type resourceFolderType struct{}
@@ -24,6 +24,11 @@ func (r resourceFolderType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Dia
 				Type:     types.StringType,
 				Computed: true,
 			},
+			"created_at": {
+				Type:          types.StringType,
+				Computed:      true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{useStateForUnknown{}},
+			},
 			"name": {
 				Type:     types.StringType,
 				Required: true,
@@ -33,33 +38,39 @@ func (r resourceFolderType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Dia
 				Optional: true,
 			},
 			"folder": {
-				Type:     types.StringType,
-				Optional: true,
+				Type:          types.StringType,
+				Optional:      true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{tfsdk.RequiresReplace()},
 			},
 			"program": {
-				Type:     types.StringType,
-				Optional: true,
+				Type:          types.StringType,
+				Optional:      true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{tfsdk.RequiresReplace()},
 			},
+			"workspace": {
+				Type:          types.StringType,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Workspace whose root folder holds the folder when neither folder nor program is set. Defaults to the workspace of the provider.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{useStateForUnknown{}, tfsdk.RequiresReplace()},
+			},
+			"timeouts": timeoutsAttribute(),
 		},
 	}, nil
 }
 
 func (r resourceFolderType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
 	return resourceFolder{
-		p: *(p.(*provider)),
+		p: p.(*provider),
 	}, nil
 }
 
 type resourceFolder struct {
-	p provider
+	p *provider
 }
 
 func (r resourceFolder) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
-	if !r.p.configured {
-		resp.Diagnostics.AddError(
-			"Provider not configured",
-			"The provider hasn't been configured before apply, likely because it depends on an unknown value from another resource. This leads to weird stuff happening, so we'd prefer if you didn't do that. Thanks!",
-		)
+	if !r.p.ready(&resp.Diagnostics) {
 		return
 	}
 
@@ -70,11 +81,26 @@ func (r resourceFolder) Create(ctx context.Context, req tfsdk.CreateResourceRequ
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, plan.Timeouts, "create")
+	defer cancel()
+
+	parent, err := workspaceParent(plan.Folder, plan.Program)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid parent",
+			err.Error(),
+		)
+		return
+	}
+
 	folder := marketo.Folder{
-		Name: plan.Name.Value,
+		Name:        plan.Name.Value,
+		Description: plan.Description.Value,
+		Parent:      parent,
+		Workspace:   plan.Workspace.Value,
 	}
 
-	result, err := r.p.client.CreateFolder(folder)
+	result, err := r.p.client().CreateFolder(ctx, folder)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating folder",
@@ -83,10 +109,10 @@ func (r resourceFolder) Create(ctx context.Context, req tfsdk.CreateResourceRequ
 		return
 	}
 
-	// update more fields once they can differ between result and plan.
-
-	plan.ID = types.String{Value: result.ID}
-	plan.LastUpdated = types.String{Value: string(time.Now().Format(time.RFC850))}
+	plan.ID = types.String{Value: strconv.Itoa(result.ID)}
+	plan.Workspace = types.String{Value: result.Workspace}
+	plan.CreatedAt = timestamp(result.CreatedAt)
+	plan.LastUpdated = timestamp(result.UpdatedAt)
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -96,6 +122,10 @@ func (r resourceFolder) Create(ctx context.Context, req tfsdk.CreateResourceRequ
 }
 
 func (r resourceFolder) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	if !r.p.refreshable(&resp.Diagnostics) {
+		return
+	}
+
 	var state Folder
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -103,8 +133,14 @@ func (r resourceFolder) Read(ctx context.Context, req tfsdk.ReadResourceRequest,
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, state.Timeouts, "read")
+	defer cancel()
+
+	// Imported folders only have an ID.
+	imported := state.Name.Null
+
 	folderID := state.ID.Value
-	folder, err := r.p.client.GetFolder(folderID)
+	folder, err := r.p.client().GetFolder(ctx, folderID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading folder",
@@ -113,8 +149,28 @@ func (r resourceFolder) Read(ctx context.Context, req tfsdk.ReadResourceRequest,
 		return
 	}
 
-	state.ID = types.String{Value: folder.ID}
+	state.ID = types.String{Value: strconv.Itoa(folder.ID)}
 	state.Name = types.String{Value: folder.Name}
+	state.CreatedAt = timestamp(folder.CreatedAt)
+	state.LastUpdated = timestamp(folder.UpdatedAt)
+	if !state.Description.Null || folder.Description != "" {
+		state.Description = types.String{Value: folder.Description}
+	}
+	state.Workspace = types.String{Value: folder.Workspace}
+	// Folders created without a parent live in the root of their workspace,
+	// which is not written back to keep the plan empty.
+	if !state.Folder.Null || !state.Program.Null {
+		setParent(folder.Parent, &state.Folder, &state.Program)
+	} else if imported {
+		err = setImportedParent(ctx, r.p.client(), folder.Parent, folder.Workspace, &state.Folder, &state.Program)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading folder",
+				"Could not find the workspace root of folder with ID "+folderID+": "+err.Error(),
+			)
+			return
+		}
+	}
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -124,6 +180,10 @@ func (r resourceFolder) Read(ctx context.Context, req tfsdk.ReadResourceRequest,
 }
 
 func (r resourceFolder) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	if !r.p.ready(&resp.Diagnostics) {
+		return
+	}
+
 	var plan Folder
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -131,6 +191,9 @@ func (r resourceFolder) Update(ctx context.Context, req tfsdk.UpdateResourceRequ
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, plan.Timeouts, "update")
+	defer cancel()
+
 	var state Folder
 	diags = req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -139,23 +202,23 @@ func (r resourceFolder) Update(ctx context.Context, req tfsdk.UpdateResourceRequ
 	}
 
 	folder := marketo.Folder{
-		Name: plan.Name.Value,
+		Name:        plan.Name.Value,
+		Description: plan.Description.Value,
 	}
 
 	folderID := state.ID.Value
-	result, err := r.p.client.UpdateFolder(folderID, folder)
+	result, err := r.p.client().UpdateFolder(ctx, folderID, folder)
 	if err != nil {
 		resp.Diagnostics.AddError(
-			"Error update order",
-			"Could not update orderID "+folderID+": "+err.Error(),
+			"Error updating folder",
+			"Could not update folder with ID "+folderID+": "+err.Error(),
 		)
 		return
 	}
 
-	// update more fields once they can differ between result and plan.
-
-	plan.ID = types.String{Value: result.ID}
-	plan.LastUpdated = types.String{Value: string(time.Now().Format(time.RFC850))}
+	plan.ID = types.String{Value: strconv.Itoa(result.ID)}
+	plan.CreatedAt = timestamp(result.CreatedAt)
+	plan.LastUpdated = timestamp(result.UpdatedAt)
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -165,6 +228,10 @@ func (r resourceFolder) Update(ctx context.Context, req tfsdk.UpdateResourceRequ
 }
 
 func (r resourceFolder) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	if !r.p.ready(&resp.Diagnostics) {
+		return
+	}
+
 	var state Folder
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -172,8 +239,11 @@ func (r resourceFolder) Delete(ctx context.Context, req tfsdk.DeleteResourceRequ
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, state.Timeouts, "delete")
+	defer cancel()
+
 	folderID := state.ID.Value
-	err := r.p.client.DeleteFolder(folderID)
+	err := r.p.client().DeleteFolder(ctx, folderID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting folder",
@@ -185,6 +255,21 @@ func (r resourceFolder) Delete(ctx context.Context, req tfsdk.DeleteResourceRequ
 	resp.State.RemoveResource(ctx)
 }
 
+// ImportState accepts the ID of the folder or "folder:<path>", for example
+// "folder:Marketing Activities/Events".
 func (r resourceFolder) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
-	tfsdk.ResourceImportStatePassthroughID(ctx, tftypes.NewAttributePath().WithAttributeName("id"), req, resp)
+	if !r.p.ready(&resp.Diagnostics) {
+		return
+	}
+
+	importByName(ctx, req, resp, "folder", func(path string) (int, error) {
+		folder, err := r.p.client().GetFolderByPath(ctx, path, "")
+		if err != nil {
+			return 0, err
+		}
+		if folder.FolderID.Type != "Folder" {
+			return 0, errors.New("path is a program, import it as marketo_program instead")
+		}
+		return folder.ID, nil
+	})
 }
@@ -2,9 +2,12 @@ package provider
 
 import (
 	"context"
-	"time"
+	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/eveld/terraform-provider-marketo/marketo"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -24,6 +27,11 @@ func (r resourceProgramType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Di
 				Type:     types.StringType,
 				Computed: true,
 			},
+			"created_at": {
+				Type:          types.StringType,
+				Computed:      true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{useStateForUnknown{}},
+			},
 			"name": {
 				Type:     types.StringType,
 				Required: true,
@@ -33,41 +41,282 @@ func (r resourceProgramType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Di
 				Optional: true,
 			},
 			"type": {
-				Type:     types.StringType,
-				Required: true,
+				Type:        types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Description: "Required unless the program is cloned, in which case it is taken from the source program. Matched ignoring case, so \"default\" is the same as \"Default\".",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					useStateForUnknown{},
+					tfsdk.RequiresReplaceIf(typeChanged, "Changing the type of a program, other than its case, replaces it.", "Changing the type of a program, other than its case, replaces it."),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					stringOneOfFold(programTypeNames),
+				},
 			},
 			"channel": {
-				Type:     types.StringType,
-				Required: true,
+				Type:          types.StringType,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Required unless the program is cloned, in which case it is taken from the source program.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{useStateForUnknown{}, tfsdk.RequiresReplace()},
 			},
 			"folder": {
-				Type:     types.StringType,
-				Optional: true,
+				Type:          types.StringType,
+				Optional:      true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{tfsdk.RequiresReplace()},
 			},
 			"program": {
-				Type:     types.StringType,
-				Optional: true,
+				Type:          types.StringType,
+				Optional:      true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{tfsdk.RequiresReplace()},
+			},
+			"workspace": {
+				Type:          types.StringType,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Workspace whose root folder holds the program when neither folder nor program is set. Defaults to the workspace of the provider.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{useStateForUnknown{}, tfsdk.RequiresReplace()},
+			},
+			"clone_from": {
+				Type:          types.StringType,
+				Optional:      true,
+				Description:   "ID of a program to clone, including all of its assets.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{tfsdk.RequiresReplace()},
+			},
+			"assets": {
+				Type: types.MapType{
+					ElemType: types.MapType{
+						ElemType: types.StringType,
+					},
+				},
+				Computed:    true,
+				Description: "IDs of the assets in the program by name, grouped by emails, smart_campaigns, smart_lists, static_lists and landing_pages.",
+			},
+			"tags": {
+				Type: types.MapType{
+					ElemType: types.StringType,
+				},
+				Optional:    true,
+				Description: "Tag values by tag type. Checked against the tag types defined in Admin when they change. Removing the attribute removes the tags from the program.",
+			},
+			"email_program": {
+				Optional:    true,
+				Description: "Schedule, A/B test and approval settings, only for programs of type Email. Read back from the program, so changes made in Marketo show up in the plan.",
+				Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+					"send_at": {
+						Type:     types.StringType,
+						Required: true,
+					},
+					"recipient_time_zone": {
+						Type:     types.BoolType,
+						Optional: true,
+					},
+					"head_start": {
+						Type:     types.BoolType,
+						Optional: true,
+					},
+					"approved": {
+						Type:     types.BoolType,
+						Optional: true,
+					},
+					"ab_test": {
+						Optional: true,
+						Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+							"type": {
+								Type:     types.StringType,
+								Required: true,
+								Validators: []tfsdk.AttributeValidator{
+									stringOneOf{"subject", "from", "date", "whole_email"},
+								},
+							},
+							"sample_size": {
+								Type:        types.Int64Type,
+								Required:    true,
+								Description: "Percentage of recipients that receive the test.",
+							},
+							"winner_criteria": {
+								Type:     types.StringType,
+								Required: true,
+								Validators: []tfsdk.AttributeValidator{
+									stringOneOf{"opens", "clicks", "clicks_to_opens", "engagement"},
+								},
+							},
+							"winner_send_at": {
+								Type:     types.StringType,
+								Optional: true,
+							},
+						}),
+					},
+				}),
 			},
+			"event": {
+				Optional:    true,
+				Description: "Schedule and webinar connector, only for programs of type Event or Event with Webinar. Read back from the program, so changes made in Marketo show up in the plan.",
+				Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+					"start_at": {
+						Type:     types.StringType,
+						Required: true,
+					},
+					"end_at": {
+						Type:     types.StringType,
+						Required: true,
+					},
+					"webinar": {
+						Optional:    true,
+						Description: "Only for programs of type Event with Webinar on a channel for events.",
+						Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+							"provider": {
+								Type:     types.StringType,
+								Required: true,
+								Validators: []tfsdk.AttributeValidator{
+									stringOneOf{"Adobe Connect", "Cvent", "GoToWebinar", "ON24", "ReadyTalk", "Webex", "Zoom"},
+								},
+							},
+							"event_id": {
+								Type:     types.StringType,
+								Required: true,
+							},
+						}),
+					},
+				}),
+			},
+			"timeouts": timeoutsAttribute(),
 		},
 	}, nil
 }
 
 func (r resourceProgramType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
 	return resourceProgram{
-		p: *(p.(*provider)),
+		p: p.(*provider),
 	}, nil
 }
 
 type resourceProgram struct {
-	p provider
+	p *provider
 }
 
-func (r resourceProgram) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
-	if !r.p.configured {
-		resp.Diagnostics.AddError(
-			"Provider not configured",
-			"The provider hasn't been configured before apply, likely because it depends on an unknown value from another resource. This leads to weird stuff happening, so we'd prefer if you didn't do that. Thanks!",
+func (r resourceProgram) ValidateConfig(ctx context.Context, req tfsdk.ValidateResourceConfigRequest, resp *tfsdk.ValidateResourceConfigResponse) {
+	var config Program
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	programType := canonicalProgramType(config.Type.Value)
+
+	if config.EmailProgram != nil && !config.Type.Null && !config.Type.Unknown && programType != "Email" {
+		resp.Diagnostics.AddAttributeError(
+			tftypes.NewAttributePath().WithAttributeName("email_program"),
+			"Invalid email program settings",
+			"Email program settings can only be set on programs of type Email, not "+config.Type.Value+".",
+		)
+	}
+
+	if config.Event != nil && !config.Type.Null && !config.Type.Unknown {
+		switch {
+		case programType != "Event" && programType != "Event with Webinar":
+			resp.Diagnostics.AddAttributeError(
+				tftypes.NewAttributePath().WithAttributeName("event"),
+				"Invalid event settings",
+				"Event settings can only be set on programs of type Event or Event with Webinar, not "+config.Type.Value+".",
+			)
+		case config.Event.Webinar != nil && programType != "Event with Webinar":
+			resp.Diagnostics.AddAttributeError(
+				tftypes.NewAttributePath().WithAttributeName("event").WithAttributeName("webinar"),
+				"Invalid webinar settings",
+				"A webinar can only be connected to programs of type Event with Webinar, not "+config.Type.Value+".",
+			)
+		}
+	}
+
+	if !config.CloneFrom.Null {
+		return
+	}
+
+	if config.Type.Null {
+		resp.Diagnostics.AddAttributeError(
+			tftypes.NewAttributePath().WithAttributeName("type"),
+			"Missing program type",
+			"The type of a program is required unless it is cloned with clone_from.",
 		)
+	}
+
+	if config.Channel.Null {
+		resp.Diagnostics.AddAttributeError(
+			tftypes.NewAttributePath().WithAttributeName("channel"),
+			"Missing program channel",
+			"The channel of a program is required unless it is cloned with clone_from.",
+		)
+	}
+}
+
+// programAssets converts the assets of a program into the nested map of the
+// assets attribute.
+func programAssets(assets *marketo.ProgramAssets) types.Map {
+	groups := map[string]map[string]int{
+		"emails":          assets.Emails,
+		"smart_campaigns": assets.SmartCampaigns,
+		"smart_lists":     assets.SmartLists,
+		"static_lists":    assets.StaticLists,
+		"landing_pages":   assets.LandingPages,
+	}
+
+	result := types.Map{
+		ElemType: types.MapType{ElemType: types.StringType},
+		Elems:    map[string]attr.Value{},
+	}
+	for group, ids := range groups {
+		elems := map[string]attr.Value{}
+		for name, id := range ids {
+			elems[name] = types.String{Value: strconv.Itoa(id)}
+		}
+		result.Elems[group] = types.Map{ElemType: types.StringType, Elems: elems}
+	}
+
+	return result
+}
+
+// applyEmailProgram unapproves the program if needed, as the settings of an
+// approved email program are locked, and approves it again when asked to.
+func (r resourceProgram) applyEmailProgram(ctx context.Context, programID string, plan *EmailProgram, wasApproved bool) error {
+	if wasApproved {
+		err := r.p.client().UnapproveProgram(ctx, programID)
+		if err != nil {
+			return err
+		}
+	}
+
+	settings := marketo.EmailProgramSettings{
+		SendAt:            plan.SendAt.Value,
+		RecipientTimeZone: plan.RecipientTimeZone.Value,
+		HeadStart:         plan.HeadStart.Value,
+	}
+	if plan.ABTest != nil {
+		settings.ABTest = &marketo.ABTest{
+			Type:           plan.ABTest.Type.Value,
+			SampleSize:     int(plan.ABTest.SampleSize.Value),
+			WinnerCriteria: plan.ABTest.WinnerCriteria.Value,
+			WinnerSendAt:   plan.ABTest.WinnerSendAt.Value,
+		}
+	}
+
+	err := r.p.client().UpdateEmailProgram(ctx, programID, settings)
+	if err != nil {
+		return err
+	}
+
+	if plan.Approved.Value {
+		return r.p.client().ApproveProgram(ctx, programID)
+	}
+	return nil
+}
+
+// ModifyPlan checks the parts of the configuration that can only be validated
+// against the API: the channel of a webinar and the program tags. This turns
+// what would be a failure halfway through apply into a plan error.
+func (r resourceProgram) ModifyPlan(ctx context.Context, req tfsdk.ModifyResourcePlanRequest, resp *tfsdk.ModifyResourcePlanResponse) {
+	if r.p.client() == nil || req.Plan.Raw.IsNull() {
 		return
 	}
 
@@ -78,11 +327,358 @@ func (r resourceProgram) Create(ctx context.Context, req tfsdk.CreateResourceReq
 		return
 	}
 
+	if plan.Event != nil && plan.Event.Webinar != nil && !plan.Channel.Unknown && !plan.Channel.Null {
+		r.validateWebinarChannel(ctx, plan, &resp.Diagnostics)
+	}
+
+	if plan.Tags.Null || plan.Tags.Unknown || plan.Type.Unknown {
+		return
+	}
+
+	// Listing the tag types on every plan of every program is wasteful, so
+	// only changed tags are checked.
+	if !req.State.Raw.IsNull() {
+		var state Program
+		diags = req.State.Get(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if plan.Tags.Equal(state.Tags) && plan.Type.Equal(state.Type) {
+			return
+		}
+	}
+
+	r.validateTags(ctx, plan, &resp.Diagnostics)
+}
+
+func (r resourceProgram) validateWebinarChannel(ctx context.Context, plan Program, diags *diag.Diagnostics) {
+	channel, err := r.p.client().GetChannelByName(ctx, plan.Channel.Value)
+	if err != nil {
+		diags.AddAttributeError(
+			tftypes.NewAttributePath().WithAttributeName("channel"),
+			"Error reading channel",
+			"Could not read channel "+plan.Channel.Value+": "+err.Error(),
+		)
+		return
+	}
+
+	if channel.ApplicableProgramType != "event" {
+		diags.AddAttributeError(
+			tftypes.NewAttributePath().WithAttributeName("channel"),
+			"Invalid channel for webinar",
+			"Channel "+channel.Name+" is for programs of type "+channel.ApplicableProgramType+", a webinar needs a channel for event programs.",
+		)
+	}
+}
+
+// validateTags checks that every tag uses a known tag type and an allowed
+// value, and that no tag type required for the program type is missing.
+func (r resourceProgram) validateTags(ctx context.Context, plan Program, diags *diag.Diagnostics) {
+	tagTypes, err := r.p.client().ListTagTypes(ctx)
+	if err != nil {
+		diags.AddAttributeError(
+			tftypes.NewAttributePath().WithAttributeName("tags"),
+			"Error reading tag types",
+			"Could not list tag types: "+err.Error(),
+		)
+		return
+	}
+
+	known := map[string]marketo.TagType{}
+	for _, tagType := range tagTypes {
+		known[tagType.Name] = tagType
+	}
+
+	for name, value := range plan.Tags.Elems {
+		path := tftypes.NewAttributePath().WithAttributeName("tags").WithElementKeyString(name)
+
+		tagType, ok := known[name]
+		if !ok {
+			diags.AddAttributeError(
+				path,
+				"Unknown tag type",
+				"Tag type "+strconv.Quote(name)+" is not defined in Admin.",
+			)
+			continue
+		}
+
+		v, ok := value.(types.String)
+		if !ok || v.Unknown || len(tagType.Values()) == 0 {
+			continue
+		}
+
+		if !contains(tagType.Values(), v.Value) {
+			diags.AddAttributeError(
+				path,
+				"Invalid tag value",
+				strconv.Quote(v.Value)+" is not an allowed value for tag type "+name+", allowed values are: "+strings.Join(tagType.Values(), ", ")+".",
+			)
+		}
+	}
+
+	if plan.Type.Null {
+		return
+	}
+
+	var missing []string
+	for _, tagType := range tagTypes {
+		if !tagType.Required {
+			continue
+		}
+
+		programTypes := tagType.ProgramTypes()
+		if len(programTypes) > 0 && !contains(programTypes, canonicalProgramType(plan.Type.Value)) {
+			continue
+		}
+
+		if _, ok := plan.Tags.Elems[tagType.Name]; !ok {
+			missing = append(missing, tagType.Name)
+		}
+	}
+
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		diags.AddAttributeError(
+			tftypes.NewAttributePath().WithAttributeName("tags"),
+			"Missing required tags",
+			"Programs of type "+plan.Type.Value+" require the tag types: "+strings.Join(missing, ", ")+".",
+		)
+	}
+}
+
+// programTags converts the tags attribute into the tags of the program, in a
+// stable order. The result is never nil, so that UpdateProgram removes the
+// tags of a program when the attribute is null or empty.
+func programTags(tags types.Map) []marketo.Tag {
+	var names []string
+	for name := range tags.Elems {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	result := []marketo.Tag{}
+	for _, name := range names {
+		if value, ok := tags.Elems[name].(types.String); ok {
+			result = append(result, marketo.Tag{Type: name, Value: value.Value})
+		}
+	}
+	return result
+}
+
+// tagsFromAPI is the inverse of programTags.
+func tagsFromAPI(tags []marketo.Tag) types.Map {
+	result := types.Map{ElemType: types.StringType, Elems: map[string]attr.Value{}}
+	for _, tag := range tags {
+		result.Elems[tag.Type] = types.String{Value: tag.Value}
+	}
+	return result
+}
+
+func eventSettings(plan *Event) marketo.EventSettings {
+	settings := marketo.EventSettings{
+		StartAt: plan.StartAt.Value,
+		EndAt:   plan.EndAt.Value,
+	}
+	if plan.Webinar != nil {
+		settings.Webinar = &marketo.Webinar{
+			Provider: plan.Webinar.Provider.Value,
+			EventID:  plan.Webinar.EventID.Value,
+		}
+	}
+	return settings
+}
+
+// eventFromAPI reads the schedule and webinar of an event program, keeping
+// the way prior has the times where the API reports the same ones.
+func eventFromAPI(program *marketo.Program, prior *Event) *Event {
+	if prior == nil {
+		prior = &Event{
+			StartAt: types.String{Null: true},
+			EndAt:   types.String{Null: true},
+		}
+	}
+
+	event := &Event{
+		StartAt: sameTime(prior.StartAt, program.StartDate),
+		EndAt:   sameTime(prior.EndAt, program.EndDate),
+	}
+	if program.WebinarProvider != "" || program.WebinarEventID != "" {
+		event.Webinar = &Webinar{
+			Provider: types.String{Value: program.WebinarProvider},
+			EventID:  types.String{Value: program.WebinarEventID},
+		}
+	}
+	return event
+}
+
+func emailProgramApproved(settings *EmailProgram) bool {
+	return settings != nil && settings.Approved.Value
+}
+
+// emailSettingsChanged reports whether the settings that can only be changed
+// while an email program is unapproved differ between plan and state.
+func emailSettingsChanged(plan *EmailProgram, state *EmailProgram) bool {
+	if state == nil {
+		return true
+	}
+
+	if !plan.SendAt.Equal(state.SendAt) || !plan.RecipientTimeZone.Equal(state.RecipientTimeZone) || !plan.HeadStart.Equal(state.HeadStart) {
+		return true
+	}
+
+	if plan.ABTest == nil || state.ABTest == nil {
+		return plan.ABTest != state.ABTest
+	}
+	return !plan.ABTest.Type.Equal(state.ABTest.Type) ||
+		!plan.ABTest.SampleSize.Equal(state.ABTest.SampleSize) ||
+		!plan.ABTest.WinnerCriteria.Equal(state.ABTest.WinnerCriteria) ||
+		!plan.ABTest.WinnerSendAt.Equal(state.ABTest.WinnerSendAt)
+}
+
+// programTypeNames are the program types as the API spells them.
+var programTypeNames = []string{"Default", "Event", "Event with Webinar", "Email", "Engagement"}
+
+// canonicalProgramType returns the spelling of the API for value, which may
+// differ in case. Values that are not a program type are returned as is.
+func canonicalProgramType(value string) string {
+	for _, name := range programTypeNames {
+		if strings.EqualFold(name, value) {
+			return name
+		}
+	}
+	return value
+}
+
+// typeChanged reports whether the type changed other than in case. A type
+// read back on import is spelled like the API, configurations may use
+// "default", which is then applied in place.
+func typeChanged(_ context.Context, state attr.Value, config attr.Value, _ *tftypes.AttributePath) (bool, diag.Diagnostics) {
+	prior, ok := state.(types.String)
+	if !ok || prior.Null || prior.Unknown {
+		return false, nil
+	}
+
+	configured, ok := config.(types.String)
+	if !ok || configured.Null {
+		return false, nil
+	}
+	if configured.Unknown {
+		return true, nil
+	}
+	return !strings.EqualFold(prior.Value, configured.Value), nil
+}
+
+// sameFold keeps prior when it is value in a different case, so that a type
+// configured as "default" does not plan a replacement once read back as
+// "Default".
+func sameFold(prior types.String, value string) types.String {
+	if !prior.Null && !prior.Unknown && strings.EqualFold(prior.Value, value) {
+		return prior
+	}
+	return types.String{Value: value}
+}
+
+// sameTime keeps prior when value is the same point in time, as the API
+// formats times differently from how they are usually configured.
+func sameTime(prior types.String, value string) types.String {
+	if !prior.Null && !prior.Unknown {
+		a, errA := marketo.ParseTime(prior.Value)
+		b, errB := marketo.ParseTime(value)
+		if errA == nil && errB == nil && a.Equal(b) {
+			return prior
+		}
+	}
+	if value == "" {
+		return types.String{Null: true}
+	}
+	return types.String{Value: value}
+}
+
+// optionalBool keeps an unset optional attribute unset while the API reports
+// the default of false.
+func optionalBool(prior types.Bool, value bool) types.Bool {
+	if prior.Null && !value {
+		return prior
+	}
+	return types.Bool{Value: value}
+}
+
+// emailProgramFromAPI reads the settings of an email program, keeping the
+// way prior has them where the API reports the same value.
+func emailProgramFromAPI(program *marketo.Program, prior *EmailProgram) *EmailProgram {
+	if prior == nil {
+		prior = &EmailProgram{
+			RecipientTimeZone: types.Bool{Null: true},
+			HeadStart:         types.Bool{Null: true},
+			Approved:          types.Bool{Null: true},
+		}
+	}
+
+	settings := &EmailProgram{
+		SendAt:            sameTime(prior.SendAt, program.StartDate),
+		RecipientTimeZone: optionalBool(prior.RecipientTimeZone, program.RecipientTimeZone),
+		HeadStart:         optionalBool(prior.HeadStart, program.HeadStart),
+		Approved:          optionalBool(prior.Approved, program.Approved()),
+	}
+
+	if program.ABTest != nil {
+		winnerSendAt := types.String{Null: true}
+		if prior.ABTest != nil {
+			winnerSendAt = prior.ABTest.WinnerSendAt
+		}
+		settings.ABTest = &ABTest{
+			Type:           types.String{Value: program.ABTest.Type},
+			SampleSize:     types.Int64{Value: int64(program.ABTest.SampleSize)},
+			WinnerCriteria: types.String{Value: program.ABTest.WinnerCriteria},
+			WinnerSendAt:   sameTime(winnerSendAt, program.ABTest.WinnerSendAt),
+		}
+	}
+
+	return settings
+}
+
+func (r resourceProgram) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	if !r.p.ready(&resp.Diagnostics) {
+		return
+	}
+
+	var plan Program
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, plan.Timeouts, "create")
+	defer cancel()
+
+	folder, err := workspaceParent(plan.Folder, plan.Program)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid parent",
+			err.Error(),
+		)
+		return
+	}
+
 	program := marketo.Program{
-		Name: plan.Name.Value,
+		Name:        plan.Name.Value,
+		Description: plan.Description.Value,
+		Type:        canonicalProgramType(plan.Type.Value),
+		Channel:     plan.Channel.Value,
+		Folder:      folder,
+		Tags:        programTags(plan.Tags),
+		Workspace:   plan.Workspace.Value,
 	}
 
-	result, err := r.p.client.CreateProgram(program)
+	var result *marketo.Program
+	if plan.CloneFrom.Null {
+		result, err = r.p.client().CreateProgram(ctx, program)
+	} else {
+		result, err = r.p.client().CloneProgram(ctx, plan.CloneFrom.Value, program)
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating program",
@@ -91,10 +687,61 @@ func (r resourceProgram) Create(ctx context.Context, req tfsdk.CreateResourceReq
 		return
 	}
 
-	// update more fields once they can differ between result and plan.
+	programID := strconv.Itoa(result.ID)
+	plan.ID = types.String{Value: programID}
+	plan.CreatedAt = timestamp(result.CreatedAt)
+
+	// Cloning cannot set tags, the clone gets those of its source instead.
+	if !plan.CloneFrom.Null && !plan.Tags.Null {
+		result, err = r.p.client().UpdateProgram(ctx, programID, program)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating program",
+				"Could not set the tags of program with ID "+programID+": "+err.Error(),
+			)
+			keepCreated(ctx, resp, plan)
+			return
+		}
+	}
+	if plan.EmailProgram != nil {
+		err = r.applyEmailProgram(ctx, programID, plan.EmailProgram, false)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating program",
+				"Could not apply email program settings to program with ID "+programID+": "+err.Error(),
+			)
+			keepCreated(ctx, resp, plan)
+			return
+		}
+	}
+
+	if plan.Event != nil {
+		err = r.p.client().UpdateEventProgram(ctx, programID, eventSettings(plan.Event))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating program",
+				"Could not apply event settings to program with ID "+programID+": "+err.Error(),
+			)
+			keepCreated(ctx, resp, plan)
+			return
+		}
+	}
+
+	assets, err := r.p.client().GetProgramAssets(ctx, programID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating program",
+			"Could not list assets of program with ID "+programID+": "+err.Error(),
+		)
+		keepCreated(ctx, resp, plan)
+		return
+	}
 
-	plan.ID = types.String{Value: result.ID}
-	plan.LastUpdated = types.String{Value: string(time.Now().Format(time.RFC850))}
+	plan.Type = sameFold(plan.Type, result.Type)
+	plan.Channel = types.String{Value: result.Channel}
+	plan.Workspace = types.String{Value: result.Workspace}
+	plan.Assets = programAssets(assets)
+	plan.LastUpdated = timestamp(result.UpdatedAt)
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -104,6 +751,10 @@ func (r resourceProgram) Create(ctx context.Context, req tfsdk.CreateResourceReq
 }
 
 func (r resourceProgram) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	if !r.p.refreshable(&resp.Diagnostics) {
+		return
+	}
+
 	var state Program
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -111,8 +762,14 @@ func (r resourceProgram) Read(ctx context.Context, req tfsdk.ReadResourceRequest
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, state.Timeouts, "read")
+	defer cancel()
+
+	// Imported programs only have an ID.
+	imported := state.Name.Null
+
 	programID := state.ID.Value
-	program, err := r.p.client.GetProgram(programID)
+	program, err := r.p.client().GetProgram(ctx, programID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading program",
@@ -121,8 +778,57 @@ func (r resourceProgram) Read(ctx context.Context, req tfsdk.ReadResourceRequest
 		return
 	}
 
-	state.ID = types.String{Value: program.ID}
+	assets, err := r.p.client().GetProgramAssets(ctx, programID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading program",
+			"Could not list assets of program with ID "+programID+": "+err.Error(),
+		)
+		return
+	}
+
+	state.ID = types.String{Value: strconv.Itoa(program.ID)}
 	state.Name = types.String{Value: program.Name}
+	state.CreatedAt = timestamp(program.CreatedAt)
+	state.LastUpdated = timestamp(program.UpdatedAt)
+	if !state.Description.Null || program.Description != "" {
+		state.Description = types.String{Value: program.Description}
+	}
+	state.Type = sameFold(state.Type, program.Type)
+	state.Channel = types.String{Value: program.Channel}
+	state.Workspace = types.String{Value: program.Workspace}
+	// Programs created without a parent live in the root of their workspace,
+	// which is not written back to keep the plan empty.
+	if !state.Folder.Null || !state.Program.Null {
+		setParent(program.Folder, &state.Folder, &state.Program)
+	} else if imported {
+		err = setImportedParent(ctx, r.p.client(), program.Folder, program.Workspace, &state.Folder, &state.Program)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading program",
+				"Could not find the workspace root of program with ID "+programID+": "+err.Error(),
+			)
+			return
+		}
+	}
+	// Tags are only refreshed when they are managed here, or filled in on
+	// import.
+	if !state.Tags.Null || (imported && len(program.Tags) > 0) {
+		state.Tags = tagsFromAPI(program.Tags)
+	}
+	if program.Type == "Email" && state.EmailProgram != nil {
+		current := emailProgramFromAPI(program, state.EmailProgram)
+		changed := emailSettingsChanged(current, state.EmailProgram) || !current.Approved.Equal(state.EmailProgram.Approved)
+		if !changed || !r.p.keepChange(&resp.Diagnostics, "email program", programID, "had its schedule, A/B test or approval changed", timestamp(program.UpdatedAt).Value, state.LastUpdated.Value) {
+			state.EmailProgram = current
+		}
+	} else if program.Type == "Email" && imported && program.StartDate != "" {
+		state.EmailProgram = emailProgramFromAPI(program, nil)
+	}
+	if strings.HasPrefix(program.Type, "Event") && (state.Event != nil || (imported && program.StartDate != "")) {
+		state.Event = eventFromAPI(program, state.Event)
+	}
+	state.Assets = programAssets(assets)
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -132,6 +838,10 @@ func (r resourceProgram) Read(ctx context.Context, req tfsdk.ReadResourceRequest
 }
 
 func (r resourceProgram) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	if !r.p.ready(&resp.Diagnostics) {
+		return
+	}
+
 	var plan Program
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -139,6 +849,9 @@ func (r resourceProgram) Update(ctx context.Context, req tfsdk.UpdateResourceReq
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, plan.Timeouts, "update")
+	defer cancel()
+
 	var state Program
 	diags = req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -147,23 +860,82 @@ func (r resourceProgram) Update(ctx context.Context, req tfsdk.UpdateResourceReq
 	}
 
 	program := marketo.Program{
-		Name: plan.Name.Value,
+		Name:        plan.Name.Value,
+		Description: plan.Description.Value,
+	}
+	if !plan.Tags.Equal(state.Tags) {
+		program.Tags = programTags(plan.Tags)
 	}
 
 	programID := state.ID.Value
-	result, err := r.p.client.UpdateProgram(programID, program)
+	result, err := r.p.client().UpdateProgram(ctx, programID, program)
 	if err != nil {
 		resp.Diagnostics.AddError(
-			"Error update order",
-			"Could not update orderID "+programID+": "+err.Error(),
+			"Error updating program",
+			"Could not update program with ID "+programID+": "+err.Error(),
 		)
 		return
 	}
 
-	// update more fields once they can differ between result and plan.
+	// Approval is only toggled when it, or a setting that is locked while
+	// approved, changes.
+	wasApproved := emailProgramApproved(state.EmailProgram)
+	switch {
+	case plan.EmailProgram != nil && emailSettingsChanged(plan.EmailProgram, state.EmailProgram):
+		err = r.applyEmailProgram(ctx, programID, plan.EmailProgram, wasApproved)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating program",
+				"Could not apply email program settings to program with ID "+programID+": "+err.Error(),
+			)
+			return
+		}
+	case emailProgramApproved(plan.EmailProgram) && !wasApproved:
+		err = r.p.client().ApproveProgram(ctx, programID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating program",
+				"Could not approve program with ID "+programID+": "+err.Error(),
+			)
+			return
+		}
+	case !emailProgramApproved(plan.EmailProgram) && wasApproved:
+		err = r.p.client().UnapproveProgram(ctx, programID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating program",
+				"Could not unapprove program with ID "+programID+": "+err.Error(),
+			)
+			return
+		}
+	}
 
-	plan.ID = types.String{Value: result.ID}
-	plan.LastUpdated = types.String{Value: string(time.Now().Format(time.RFC850))}
+	if plan.Event != nil {
+		err = r.p.client().UpdateEventProgram(ctx, programID, eventSettings(plan.Event))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating program",
+				"Could not apply event settings to program with ID "+programID+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	assets, err := r.p.client().GetProgramAssets(ctx, programID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating program",
+			"Could not list assets of program with ID "+programID+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.String{Value: strconv.Itoa(result.ID)}
+	plan.Type = sameFold(plan.Type, result.Type)
+	plan.Channel = types.String{Value: result.Channel}
+	plan.Assets = programAssets(assets)
+	plan.CreatedAt = timestamp(result.CreatedAt)
+	plan.LastUpdated = timestamp(result.UpdatedAt)
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -173,6 +945,10 @@ func (r resourceProgram) Update(ctx context.Context, req tfsdk.UpdateResourceReq
 }
 
 func (r resourceProgram) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	if !r.p.ready(&resp.Diagnostics) {
+		return
+	}
+
 	var state Program
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -180,8 +956,22 @@ func (r resourceProgram) Delete(ctx context.Context, req tfsdk.DeleteResourceReq
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, state.Timeouts, "delete")
+	defer cancel()
+
 	programID := state.ID.Value
-	err := r.p.client.DeleteProgram(programID)
+	if emailProgramApproved(state.EmailProgram) {
+		err := r.p.client().UnapproveProgram(ctx, programID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error deleting program",
+				"Could not unapprove program with ID "+programID+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	err := r.p.client().DeleteProgram(ctx, programID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting program",
@@ -193,6 +983,17 @@ func (r resourceProgram) Delete(ctx context.Context, req tfsdk.DeleteResourceReq
 	resp.State.RemoveResource(ctx)
 }
 
+// ImportState accepts the ID of the program or "program:<name>".
 func (r resourceProgram) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
-	tfsdk.ResourceImportStatePassthroughID(ctx, tftypes.NewAttributePath().WithAttributeName("id"), req, resp)
+	if !r.p.ready(&resp.Diagnostics) {
+		return
+	}
+
+	importByName(ctx, req, resp, "program", func(name string) (int, error) {
+		program, err := r.p.client().GetProgramByName(ctx, name, nil)
+		if err != nil {
+			return 0, err
+		}
+		return program.ID, nil
+	})
 }
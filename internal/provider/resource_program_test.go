@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestProgramTypeIgnoresCase(t *testing.T) {
+	path := tftypes.NewAttributePath().WithAttributeName("type")
+	for _, value := range []string{"default", "Default", "event with webinar"} {
+		resp := &tfsdk.ValidateAttributeResponse{}
+		stringOneOfFold(programTypeNames).Validate(context.Background(), tfsdk.ValidateAttributeRequest{
+			AttributePath:   path,
+			AttributeConfig: types.String{Value: value},
+		}, resp)
+		if resp.Diagnostics.HasError() {
+			t.Errorf("%q is not a valid type: %v", value, resp.Diagnostics)
+		}
+	}
+
+	if got := canonicalProgramType("event with webinar"); got != "Event with Webinar" {
+		t.Errorf("got %q, want the spelling of the API", got)
+	}
+	if got := sameFold(types.String{Value: "default"}, "Default"); got.Value != "default" {
+		t.Errorf("got %q, want the configured spelling", got.Value)
+	}
+
+	tests := []struct {
+		state  string
+		config types.String
+		want   bool
+	}{
+		{"Default", types.String{Value: "default"}, false},
+		{"Default", types.String{Value: "Email"}, true},
+		{"Default", types.String{Null: true}, false},
+		{"Default", types.String{Unknown: true}, true},
+	}
+	for _, test := range tests {
+		got, _ := typeChanged(context.Background(), types.String{Value: test.state}, test.config, path)
+		if got != test.want {
+			t.Errorf("typeChanged(%q, %v) = %t, want %t", test.state, test.config, got, test.want)
+		}
+	}
+}
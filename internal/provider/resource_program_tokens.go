@@ -0,0 +1,380 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/eveld/terraform-provider-marketo/marketo"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type resourceProgramTokensType struct{}
+
+func (r resourceProgramTokensType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: "Manages the full set of My Tokens on a program or folder. Tokens that are not declared are removed.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"last_updated": {
+				Type:        types.StringType,
+				Computed:    true,
+				Description: "When the folder or program holding the tokens was last updated.",
+			},
+			"created_at": {
+				Type:          types.StringType,
+				Computed:      true,
+				Description:   "When the folder or program holding the tokens was created.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{useStateForUnknown{}},
+			},
+			"folder": {
+				Type:          types.StringType,
+				Optional:      true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{tfsdk.RequiresReplace()},
+			},
+			"program": {
+				Type:          types.StringType,
+				Optional:      true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{tfsdk.RequiresReplace()},
+			},
+			"tokens": {
+				Required: true,
+				Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
+					"name": {
+						Type:     types.StringType,
+						Required: true,
+					},
+					"type": {
+						Type:     types.StringType,
+						Required: true,
+						Validators: []tfsdk.AttributeValidator{
+							stringOneOf{"date", "number", "rich text", "score", "sfdc campaign", "text"},
+						},
+					},
+					"value": {
+						Type:     types.StringType,
+						Required: true,
+					},
+				}, tfsdk.ListNestedAttributesOptions{}),
+			},
+			"timeouts": timeoutsAttribute(),
+		},
+	}, nil
+}
+
+func (r resourceProgramTokensType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return resourceProgramTokens{
+		p: p.(*provider),
+	}, nil
+}
+
+type resourceProgramTokens struct {
+	p *provider
+}
+
+// tokensID encodes the parent as "<type>:<id>", e.g. "program:1234", so the
+// parent can be recovered on import.
+func tokensResourceID(folder marketo.FolderID) string {
+	return strings.ToLower(folder.Type) + ":" + strconv.Itoa(folder.ID)
+}
+
+func parseTokensResourceID(id string) (marketo.FolderID, bool) {
+	parts := strings.SplitN(id, ":", 2)
+	if len(parts) != 2 {
+		return marketo.FolderID{}, false
+	}
+
+	kind, ok := map[string]string{"folder": "Folder", "program": "Program"}[parts[0]]
+	if !ok {
+		return marketo.FolderID{}, false
+	}
+
+	folderID, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return marketo.FolderID{}, false
+	}
+
+	return marketo.FolderID{ID: folderID, Type: kind}, true
+}
+
+// applyTokens makes the tokens on the parent match plan exactly, deleting any
+// token that is not declared.
+func (r resourceProgramTokens) applyTokens(ctx context.Context, folder marketo.FolderID, plan ProgramTokens) error {
+	existing, err := r.p.client().GetTokens(ctx, folder)
+	if err != nil {
+		return err
+	}
+
+	declared := map[string]bool{}
+	for _, token := range plan.Tokens {
+		declared[token.Name.Value] = true
+	}
+
+	for _, token := range existing {
+		if declared[token.Name] {
+			continue
+		}
+
+		err = r.p.client().DeleteToken(ctx, folder, token)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, token := range plan.Tokens {
+		err = r.p.client().SetToken(ctx, folder, marketo.Token{
+			Name:  token.Name.Value,
+			Type:  token.Type.Value,
+			Value: token.Value.Value,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r resourceProgramTokens) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	if !r.p.ready(&resp.Diagnostics) {
+		return
+	}
+
+	var plan ProgramTokens
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, plan.Timeouts, "create")
+	defer cancel()
+
+	folder, err := parentFolder(plan.Folder, plan.Program)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid parent",
+			err.Error(),
+		)
+		return
+	}
+
+	err = r.applyTokens(ctx, folder, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating tokens",
+			"Could not set tokens, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.String{Value: tokensResourceID(folder)}
+	plan.CreatedAt, plan.LastUpdated, err = containerTimestamps(ctx, r.p.client(), folder)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating tokens",
+			"Could not read the parent of the tokens: "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r resourceProgramTokens) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	if !r.p.refreshable(&resp.Diagnostics) {
+		return
+	}
+
+	var state ProgramTokens
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, state.Timeouts, "read")
+	defer cancel()
+
+	tokensID := state.ID.Value
+	folder, ok := parseTokensResourceID(tokensID)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Error reading tokens",
+			"Invalid ID "+tokensID+", expected folder:<id> or program:<id>",
+		)
+		return
+	}
+
+	tokens, err := r.p.client().GetTokens(ctx, folder)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading tokens",
+			"Could not read tokens of "+tokensID+": "+err.Error(),
+		)
+		return
+	}
+
+	remote := map[string]marketo.Token{}
+	for _, token := range tokens {
+		remote[token.Name] = token
+	}
+
+	// Keep the configured order and append tokens that were added outside of
+	// Terraform, so they show up as a diff.
+	var result []Token
+	for _, token := range state.Tokens {
+		if t, ok := remote[token.Name.Value]; ok {
+			result = append(result, tokenFromAPI(t))
+			delete(remote, token.Name.Value)
+		}
+	}
+	for _, token := range tokens {
+		if _, ok := remote[token.Name]; ok {
+			result = append(result, tokenFromAPI(token))
+		}
+	}
+
+	state.Tokens = result
+	state.CreatedAt, state.LastUpdated, err = containerTimestamps(ctx, r.p.client(), folder)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading tokens",
+			"Could not read the parent of "+tokensID+": "+err.Error(),
+		)
+		return
+	}
+	if folder.Type == "Program" {
+		state.Program = types.String{Value: strconv.Itoa(folder.ID)}
+	} else {
+		state.Folder = types.String{Value: strconv.Itoa(folder.ID)}
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func tokenFromAPI(token marketo.Token) Token {
+	return Token{
+		Name:  types.String{Value: token.Name},
+		Type:  types.String{Value: token.Type},
+		Value: types.String{Value: token.Value},
+	}
+}
+
+func (r resourceProgramTokens) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	if !r.p.ready(&resp.Diagnostics) {
+		return
+	}
+
+	var plan ProgramTokens
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, plan.Timeouts, "update")
+	defer cancel()
+
+	var state ProgramTokens
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tokensID := state.ID.Value
+	folder, ok := parseTokensResourceID(tokensID)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Error updating tokens",
+			"Invalid ID "+tokensID+", expected folder:<id> or program:<id>",
+		)
+		return
+	}
+
+	err := r.applyTokens(ctx, folder, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating tokens",
+			"Could not update tokens of "+tokensID+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = state.ID
+	plan.CreatedAt, plan.LastUpdated, err = containerTimestamps(ctx, r.p.client(), folder)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating tokens",
+			"Could not read the parent of the tokens: "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r resourceProgramTokens) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	if !r.p.ready(&resp.Diagnostics) {
+		return
+	}
+
+	var state ProgramTokens
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, state.Timeouts, "delete")
+	defer cancel()
+
+	tokensID := state.ID.Value
+	folder, ok := parseTokensResourceID(tokensID)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Error deleting tokens",
+			"Invalid ID "+tokensID+", expected folder:<id> or program:<id>",
+		)
+		return
+	}
+
+	for _, token := range state.Tokens {
+		err := r.p.client().DeleteToken(ctx, folder, marketo.Token{
+			Name: token.Name.Value,
+			Type: token.Type.Value,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error deleting tokens",
+				"Could not delete token "+token.Name.Value+" of "+tokensID+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r resourceProgramTokens) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	tfsdk.ResourceImportStatePassthroughID(ctx, tftypes.NewAttributePath().WithAttributeName("id"), req, resp)
+}
@@ -0,0 +1,480 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/eveld/terraform-provider-marketo/marketo"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type resourceSegmentationType struct{}
+
+func (r resourceSegmentationType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"last_updated": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"created_at": {
+				Type:          types.StringType,
+				Computed:      true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{useStateForUnknown{}},
+			},
+			"last_applied": {
+				Type:        types.StringType,
+				Computed:    true,
+				Description: "When Terraform last wrote the segmentation, used to detect changes made in Marketo.",
+			},
+			"content_hash": {
+				Type:        types.StringType,
+				Computed:    true,
+				Description: "Hash of the content of the segmentation as Terraform last wrote it.",
+			},
+			"name": {
+				Type:     types.StringType,
+				Required: true,
+			},
+			"description": {
+				Type:     types.StringType,
+				Optional: true,
+			},
+			"folder": {
+				Type:          types.StringType,
+				Required:      true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{tfsdk.RequiresReplace()},
+			},
+			"approved": {
+				Type:     types.BoolType,
+				Optional: true,
+			},
+			"status": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"segments": {
+				Computed:    true,
+				Description: "Segments of the segmentation. Segment rules are edited in the Marketo UI.",
+				Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
+					"id": {
+						Type:     types.StringType,
+						Computed: true,
+					},
+					"name": {
+						Type:     types.StringType,
+						Computed: true,
+					},
+				}, tfsdk.ListNestedAttributesOptions{}),
+			},
+			"timeouts": timeoutsAttribute(),
+		},
+	}, nil
+}
+
+func (r resourceSegmentationType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return resourceSegmentation{
+		p: p.(*provider),
+	}, nil
+}
+
+type resourceSegmentation struct {
+	p *provider
+}
+
+var segmentType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"id":   types.StringType,
+		"name": types.StringType,
+	},
+}
+
+// segmentsFromAPI builds the segments attribute. It is a types.List rather
+// than a slice because it is unknown in the plan until the segmentation is
+// read back.
+func segmentsFromAPI(segments []marketo.Segment) types.List {
+	result := types.List{
+		ElemType: segmentType,
+		Elems:    []attr.Value{},
+	}
+	for _, segment := range segments {
+		result.Elems = append(result.Elems, types.Object{
+			AttrTypes: segmentType.AttrTypes,
+			Attrs: map[string]attr.Value{
+				"id":   types.String{Value: strconv.Itoa(segment.ID)},
+				"name": types.String{Value: segment.Name},
+			},
+		})
+	}
+	return result
+}
+
+// segmentsHash hashes the segments of a segmentation as the API returns them.
+func segmentsHash(segments []marketo.Segment) string {
+	var parts []string
+	for _, segment := range segments {
+		parts = append(parts, strconv.Itoa(segment.ID), segment.Name)
+	}
+	return contentHash(parts...)
+}
+
+// segmentsFromState is the inverse of segmentsFromAPI.
+func segmentsFromState(list types.List) []marketo.Segment {
+	var segments []marketo.Segment
+	for _, elem := range list.Elems {
+		object, ok := elem.(types.Object)
+		if !ok {
+			continue
+		}
+
+		var segment marketo.Segment
+		if id, ok := object.Attrs["id"].(types.String); ok {
+			segment.ID, _ = strconv.Atoi(id.Value)
+		}
+		if name, ok := object.Attrs["name"].(types.String); ok {
+			segment.Name = name.Value
+		}
+		segments = append(segments, segment)
+	}
+	return segments
+}
+
+// ModifyPlan turns segments changed in Marketo into a change of content_hash.
+// Segments are computed, so without it the drift would only show up in state.
+// Segment rules cannot be written through the API, so applying the plan
+// accepts the change instead of reverting it.
+func (r resourceSegmentation) ModifyPlan(ctx context.Context, req tfsdk.ModifyResourcePlanRequest, resp *tfsdk.ModifyResourcePlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state Segmentation
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.ContentHash.Null || state.Segments.Null || state.Segments.Unknown {
+		return
+	}
+
+	hash := segmentsHash(segmentsFromState(state.Segments))
+	if hash == state.ContentHash.Value {
+		return
+	}
+
+	for name, value := range map[string]attr.Value{
+		"content_hash": types.String{Value: hash},
+		"last_updated": types.String{Unknown: true},
+		"last_applied": types.String{Unknown: true},
+		"status":       types.String{Unknown: true},
+	} {
+		diags = resp.Plan.SetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName(name), value)
+		resp.Diagnostics.Append(diags...)
+	}
+}
+
+// version reads back the updatedAt and segments of a segmentation after it
+// was written.
+func (r resourceSegmentation) version(ctx context.Context, segmentationID string) (string, []marketo.Segment, error) {
+	segmentation, err := r.p.client().GetSegmentation(ctx, segmentationID)
+	if err != nil {
+		return "", nil, err
+	}
+
+	segments, err := r.p.client().GetSegments(ctx, segmentationID)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return segmentation.UpdatedAt, segments, nil
+}
+
+func (r resourceSegmentation) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	if !r.p.ready(&resp.Diagnostics) {
+		return
+	}
+
+	var plan Segmentation
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, plan.Timeouts, "create")
+	defer cancel()
+
+	folder, err := parentFolder(plan.Folder, types.String{Null: true})
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			tftypes.NewAttributePath().WithAttributeName("folder"),
+			"Invalid folder",
+			err.Error(),
+		)
+		return
+	}
+
+	segmentation := marketo.Segmentation{
+		Name:        plan.Name.Value,
+		Description: plan.Description.Value,
+		Folder:      folder,
+	}
+
+	result, err := r.p.client().CreateSegmentation(ctx, segmentation)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating segmentation",
+			"Could not create segmentation, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	segmentationID := strconv.Itoa(result.ID)
+	plan.ID = types.String{Value: segmentationID}
+	plan.CreatedAt = timestamp(result.CreatedAt)
+	status := result.Status
+	if plan.Approved.Value {
+		err = r.p.client().ApproveSegmentation(ctx, segmentationID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error approving segmentation",
+				"Could not approve segmentation with ID "+segmentationID+": "+err.Error(),
+			)
+			keepCreated(ctx, resp, plan)
+			return
+		}
+		status = "approved"
+	}
+
+	updatedAt, segments, err := r.version(ctx, segmentationID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating segmentation",
+			"Could not read back segmentation with ID "+segmentationID+": "+err.Error(),
+		)
+		keepCreated(ctx, resp, plan)
+		return
+	}
+
+	plan.Status = types.String{Value: status}
+	plan.Segments = segmentsFromAPI(segments)
+	plan.LastUpdated = timestamp(updatedAt)
+	plan.LastApplied = plan.LastUpdated
+	plan.ContentHash = types.String{Value: segmentsHash(segments)}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r resourceSegmentation) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	if !r.p.refreshable(&resp.Diagnostics) {
+		return
+	}
+
+	var state Segmentation
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, state.Timeouts, "read")
+	defer cancel()
+
+	imported := state.Name.Null
+	segmentationID := state.ID.Value
+	segmentation, err := r.p.client().GetSegmentation(ctx, segmentationID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading segmentation",
+			"Could not read segmentation with ID "+segmentationID+": "+err.Error(),
+		)
+		return
+	}
+
+	segments, err := r.p.client().GetSegments(ctx, segmentationID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading segmentation",
+			"Could not read segments of segmentation with ID "+segmentationID+": "+err.Error(),
+		)
+		return
+	}
+
+	hash := segmentsHash(segments)
+	if r.p.keepOnDrift(&resp.Diagnostics, "segmentation", segmentationID, state.LastApplied, state.ContentHash, segmentation.UpdatedAt, hash) {
+		state.LastUpdated = timestamp(segmentation.UpdatedAt)
+		diags = resp.State.Set(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	state.ID = types.String{Value: strconv.Itoa(segmentation.ID)}
+	state.Name = types.String{Value: segmentation.Name}
+	state.CreatedAt = timestamp(segmentation.CreatedAt)
+	state.LastUpdated = timestamp(segmentation.UpdatedAt)
+	if !state.Description.Null || segmentation.Description != "" {
+		state.Description = types.String{Value: segmentation.Description}
+	}
+	state.Folder = types.String{Value: strconv.Itoa(segmentation.Folder.ID)}
+	state.Status = types.String{Value: segmentation.Status}
+	if !state.Approved.Null || (imported && segmentation.Status == "approved") {
+		state.Approved = types.Bool{Value: segmentation.Status == "approved"}
+	}
+	state.Segments = segmentsFromAPI(segments)
+
+	// Imported segmentations and state written before drift was tracked take the
+	// current version as the one Terraform last wrote.
+	if state.LastApplied.Null {
+		state.LastApplied = state.LastUpdated
+		state.ContentHash = types.String{Value: hash}
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r resourceSegmentation) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	if !r.p.ready(&resp.Diagnostics) {
+		return
+	}
+
+	var plan Segmentation
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, plan.Timeouts, "update")
+	defer cancel()
+
+	var state Segmentation
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	segmentation := marketo.Segmentation{
+		Name:        plan.Name.Value,
+		Description: plan.Description.Value,
+	}
+
+	segmentationID := state.ID.Value
+	result, err := r.p.client().UpdateSegmentation(ctx, segmentationID, segmentation)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating segmentation",
+			"Could not update segmentation with ID "+segmentationID+": "+err.Error(),
+		)
+		return
+	}
+
+	status := result.Status
+	if plan.Approved.Value && !state.Approved.Value {
+		err = r.p.client().ApproveSegmentation(ctx, segmentationID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error approving segmentation",
+				"Could not approve segmentation with ID "+segmentationID+": "+err.Error(),
+			)
+			return
+		}
+		status = "approved"
+	} else if !plan.Approved.Value && state.Approved.Value {
+		err = r.p.client().UnapproveSegmentation(ctx, segmentationID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error unapproving segmentation",
+				"Could not unapprove segmentation with ID "+segmentationID+": "+err.Error(),
+			)
+			return
+		}
+		status = "draft"
+	}
+
+	updatedAt, segments, err := r.version(ctx, segmentationID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating segmentation",
+			"Could not read back segmentation with ID "+segmentationID+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.String{Value: strconv.Itoa(result.ID)}
+	plan.Status = types.String{Value: status}
+	plan.Segments = segmentsFromAPI(segments)
+	plan.CreatedAt = timestamp(result.CreatedAt)
+	plan.LastUpdated = timestamp(updatedAt)
+	plan.LastApplied = plan.LastUpdated
+	plan.ContentHash = types.String{Value: segmentsHash(segments)}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r resourceSegmentation) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	if !r.p.ready(&resp.Diagnostics) {
+		return
+	}
+
+	var state Segmentation
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, state.Timeouts, "delete")
+	defer cancel()
+
+	segmentationID := state.ID.Value
+	if state.Approved.Value {
+		// Approved segmentations have to be unapproved before they can be
+		// deleted.
+		err := r.p.client().UnapproveSegmentation(ctx, segmentationID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error deleting segmentation",
+				"Could not unapprove segmentation with ID "+segmentationID+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	err := r.p.client().DeleteSegmentation(ctx, segmentationID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting segmentation",
+			"Could not delete segmentation with ID "+segmentationID+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r resourceSegmentation) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	tfsdk.ResourceImportStatePassthroughID(ctx, tftypes.NewAttributePath().WithAttributeName("id"), req, resp)
+}
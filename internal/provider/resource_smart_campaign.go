@@ -2,7 +2,7 @@ package provider
 
 import (
 	"context"
-	"time"
+	"strconv"
 
 	"github.com/eveld/terraform-provider-marketo/marketo"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -24,6 +24,11 @@ func (r resourceSmartCampaignType) GetSchema(_ context.Context) (tfsdk.Schema, d
 				Type:     types.StringType,
 				Computed: true,
 			},
+			"created_at": {
+				Type:          types.StringType,
+				Computed:      true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{useStateForUnknown{}},
+			},
 			"name": {
 				Type:     types.StringType,
 				Required: true,
@@ -33,12 +38,14 @@ func (r resourceSmartCampaignType) GetSchema(_ context.Context) (tfsdk.Schema, d
 				Optional: true,
 			},
 			"folder": {
-				Type:     types.StringType,
-				Optional: true,
+				Type:          types.StringType,
+				Optional:      true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{tfsdk.RequiresReplace()},
 			},
 			"program": {
-				Type:     types.StringType,
-				Optional: true,
+				Type:          types.StringType,
+				Optional:      true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{tfsdk.RequiresReplace()},
 			},
 			"schedule": {
 				Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
@@ -55,26 +62,35 @@ func (r resourceSmartCampaignType) GetSchema(_ context.Context) (tfsdk.Schema, d
 				}),
 				Optional: true,
 			},
+			"timeouts": timeoutsAttribute(),
 		},
 	}, nil
 }
 
 func (r resourceSmartCampaignType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
 	return resourceSmartCampaign{
-		p: *(p.(*provider)),
+		p: p.(*provider),
 	}, nil
 }
 
 type resourceSmartCampaign struct {
-	p provider
+	p *provider
+}
+
+// schedule runs the campaign at the configured time. Marketo does not report
+// scheduled runs back, so the schedule is never refreshed.
+func (r resourceSmartCampaign) schedule(ctx context.Context, id string, schedule *Schedule) error {
+	tokens := map[string]string{}
+	for name, value := range schedule.Tokens.Elems {
+		if v, ok := value.(types.String); ok {
+			tokens[name] = v.Value
+		}
+	}
+	return r.p.client().ScheduleSmartCampaign(ctx, id, schedule.RunAt.Value, tokens)
 }
 
 func (r resourceSmartCampaign) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
-	if !r.p.configured {
-		resp.Diagnostics.AddError(
-			"Provider not configured",
-			"The provider hasn't been configured before apply, likely because it depends on an unknown value from another resource. This leads to weird stuff happening, so we'd prefer if you didn't do that. Thanks!",
-		)
+	if !r.p.ready(&resp.Diagnostics) {
 		return
 	}
 
@@ -85,23 +101,48 @@ func (r resourceSmartCampaign) Create(ctx context.Context, req tfsdk.CreateResou
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, plan.Timeouts, "create")
+	defer cancel()
+
+	parent, err := parentFolder(plan.Folder, plan.Program)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid parent",
+			err.Error(),
+		)
+		return
+	}
+
 	smartCampaign := marketo.SmartCampaign{
-		Name: plan.Name.Value,
+		Name:        plan.Name.Value,
+		Description: plan.Description.Value,
+		Folder:      parent,
 	}
 
-	result, err := r.p.client.CreateSmartCampaign(smartCampaign)
+	result, err := r.p.client().CreateSmartCampaign(ctx, smartCampaign)
 	if err != nil {
 		resp.Diagnostics.AddError(
-			"Error creating smartCampaign",
-			"Could not create smartCampaign, unexpected error: "+err.Error(),
+			"Error creating smart campaign",
+			"Could not create smart campaign, unexpected error: "+err.Error(),
 		)
 		return
 	}
 
-	// update more fields once they can differ between result and plan.
-
-	plan.ID = types.String{Value: result.ID}
-	plan.LastUpdated = types.String{Value: string(time.Now().Format(time.RFC850))}
+	smartCampaignID := strconv.Itoa(result.ID)
+	plan.ID = types.String{Value: smartCampaignID}
+	plan.CreatedAt = timestamp(result.CreatedAt)
+	plan.LastUpdated = timestamp(result.UpdatedAt)
+	if plan.Schedule != nil {
+		err = r.schedule(ctx, smartCampaignID, plan.Schedule)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating smart campaign",
+				"Could not schedule smart campaign with ID "+smartCampaignID+": "+err.Error(),
+			)
+			keepCreated(ctx, resp, plan)
+			return
+		}
+	}
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -111,6 +152,10 @@ func (r resourceSmartCampaign) Create(ctx context.Context, req tfsdk.CreateResou
 }
 
 func (r resourceSmartCampaign) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	if !r.p.refreshable(&resp.Diagnostics) {
+		return
+	}
+
 	var state SmartCampaign
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -118,18 +163,27 @@ func (r resourceSmartCampaign) Read(ctx context.Context, req tfsdk.ReadResourceR
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, state.Timeouts, "read")
+	defer cancel()
+
 	smartCampaignID := state.ID.Value
-	smartCampaign, err := r.p.client.GetSmartCampaign(smartCampaignID)
+	smartCampaign, err := r.p.client().GetSmartCampaign(ctx, smartCampaignID)
 	if err != nil {
 		resp.Diagnostics.AddError(
-			"Error reading smartCampaign",
-			"Could not read smartCampaign with ID "+smartCampaignID+": "+err.Error(),
+			"Error reading smart campaign",
+			"Could not read smart campaign with ID "+smartCampaignID+": "+err.Error(),
 		)
 		return
 	}
 
-	state.ID = types.String{Value: smartCampaign.ID}
+	state.ID = types.String{Value: strconv.Itoa(smartCampaign.ID)}
 	state.Name = types.String{Value: smartCampaign.Name}
+	state.CreatedAt = timestamp(smartCampaign.CreatedAt)
+	state.LastUpdated = timestamp(smartCampaign.UpdatedAt)
+	if !state.Description.Null || smartCampaign.Description != "" {
+		state.Description = types.String{Value: smartCampaign.Description}
+	}
+	setParent(smartCampaign.Folder, &state.Folder, &state.Program)
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -139,6 +193,10 @@ func (r resourceSmartCampaign) Read(ctx context.Context, req tfsdk.ReadResourceR
 }
 
 func (r resourceSmartCampaign) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	if !r.p.ready(&resp.Diagnostics) {
+		return
+	}
+
 	var plan SmartCampaign
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -146,6 +204,9 @@ func (r resourceSmartCampaign) Update(ctx context.Context, req tfsdk.UpdateResou
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, plan.Timeouts, "update")
+	defer cancel()
+
 	var state SmartCampaign
 	diags = req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -154,23 +215,34 @@ func (r resourceSmartCampaign) Update(ctx context.Context, req tfsdk.UpdateResou
 	}
 
 	smartCampaign := marketo.SmartCampaign{
-		Name: plan.Name.Value,
+		Name:        plan.Name.Value,
+		Description: plan.Description.Value,
 	}
 
 	smartCampaignID := state.ID.Value
-	result, err := r.p.client.UpdateSmartCampaign(smartCampaignID, smartCampaign)
+	result, err := r.p.client().UpdateSmartCampaign(ctx, smartCampaignID, smartCampaign)
 	if err != nil {
 		resp.Diagnostics.AddError(
-			"Error update order",
-			"Could not update orderID "+smartCampaignID+": "+err.Error(),
+			"Error updating smart campaign",
+			"Could not update smart campaign with ID "+smartCampaignID+": "+err.Error(),
 		)
 		return
 	}
 
-	// update more fields once they can differ between result and plan.
+	if plan.Schedule != nil && (state.Schedule == nil || !plan.Schedule.RunAt.Equal(state.Schedule.RunAt) || !plan.Schedule.Tokens.Equal(state.Schedule.Tokens)) {
+		err = r.schedule(ctx, smartCampaignID, plan.Schedule)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating smart campaign",
+				"Could not schedule smart campaign with ID "+smartCampaignID+": "+err.Error(),
+			)
+			return
+		}
+	}
 
-	plan.ID = types.String{Value: result.ID}
-	plan.LastUpdated = types.String{Value: string(time.Now().Format(time.RFC850))}
+	plan.ID = types.String{Value: strconv.Itoa(result.ID)}
+	plan.CreatedAt = timestamp(result.CreatedAt)
+	plan.LastUpdated = timestamp(result.UpdatedAt)
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -180,6 +252,10 @@ func (r resourceSmartCampaign) Update(ctx context.Context, req tfsdk.UpdateResou
 }
 
 func (r resourceSmartCampaign) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	if !r.p.ready(&resp.Diagnostics) {
+		return
+	}
+
 	var state SmartCampaign
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -187,8 +263,11 @@ func (r resourceSmartCampaign) Delete(ctx context.Context, req tfsdk.DeleteResou
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, state.Timeouts, "delete")
+	defer cancel()
+
 	smartCampaignID := state.ID.Value
-	err := r.p.client.DeleteSmartCampaign(smartCampaignID)
+	err := r.p.client().DeleteSmartCampaign(ctx, smartCampaignID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting smartCampaign",
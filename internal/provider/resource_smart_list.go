@@ -2,7 +2,7 @@ package provider
 
 import (
 	"context"
-	"time"
+	"strconv"
 
 	"github.com/eveld/terraform-provider-marketo/marketo"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -24,6 +24,11 @@ func (r resourceSmartListType) GetSchema(_ context.Context) (tfsdk.Schema, diag.
 				Type:     types.StringType,
 				Computed: true,
 			},
+			"created_at": {
+				Type:          types.StringType,
+				Computed:      true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{useStateForUnknown{}},
+			},
 			"name": {
 				Type:     types.StringType,
 				Required: true,
@@ -33,37 +38,39 @@ func (r resourceSmartListType) GetSchema(_ context.Context) (tfsdk.Schema, diag.
 				Optional: true,
 			},
 			"folder": {
-				Type:     types.StringType,
-				Optional: true,
+				Type:          types.StringType,
+				Optional:      true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{tfsdk.RequiresReplace()},
 			},
 			"program": {
-				Type:     types.StringType,
-				Optional: true,
+				Type:          types.StringType,
+				Optional:      true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{tfsdk.RequiresReplace()},
 			},
 			"source": {
-				Type:     types.StringType,
-				Required: true,
+				Type:          types.StringType,
+				Optional:      true,
+				Computed:      true,
+				Description:   "ID of the smart list to clone the rules from. Required to create a smart list. Imported smart lists are their own source, so it can be left out for them.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{useStateForUnknown{}, tfsdk.RequiresReplace()},
 			},
+			"timeouts": timeoutsAttribute(),
 		},
 	}, nil
 }
 
 func (r resourceSmartListType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
 	return resourceSmartList{
-		p: *(p.(*provider)),
+		p: p.(*provider),
 	}, nil
 }
 
 type resourceSmartList struct {
-	p provider
+	p *provider
 }
 
 func (r resourceSmartList) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
-	if !r.p.configured {
-		resp.Diagnostics.AddError(
-			"Provider not configured",
-			"The provider hasn't been configured before apply, likely because it depends on an unknown value from another resource. This leads to weird stuff happening, so we'd prefer if you didn't do that. Thanks!",
-		)
+	if !r.p.ready(&resp.Diagnostics) {
 		return
 	}
 
@@ -74,23 +81,44 @@ func (r resourceSmartList) Create(ctx context.Context, req tfsdk.CreateResourceR
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, plan.Timeouts, "create")
+	defer cancel()
+
+	parent, err := parentFolder(plan.Folder, plan.Program)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid parent",
+			err.Error(),
+		)
+		return
+	}
+
+	if plan.Source.Null || plan.Source.Unknown {
+		resp.Diagnostics.AddError(
+			"Missing source",
+			"The rules of a smart list cannot be written through the API, so source must be set to create one. Only imported smart lists can leave it out.",
+		)
+		return
+	}
+
 	smartList := marketo.SmartList{
-		Name: plan.Name.Value,
+		Name:        plan.Name.Value,
+		Description: plan.Description.Value,
+		Folder:      parent,
 	}
 
-	result, err := r.p.client.CreateSmartList(smartList)
+	result, err := r.p.client().CreateSmartList(ctx, plan.Source.Value, smartList)
 	if err != nil {
 		resp.Diagnostics.AddError(
-			"Error creating smartList",
-			"Could not create smartList, unexpected error: "+err.Error(),
+			"Error creating smart list",
+			"Could not create smart list, unexpected error: "+err.Error(),
 		)
 		return
 	}
 
-	// update more fields once they can differ between result and plan.
-
-	plan.ID = types.String{Value: result.ID}
-	plan.LastUpdated = types.String{Value: string(time.Now().Format(time.RFC850))}
+	plan.ID = types.String{Value: strconv.Itoa(result.ID)}
+	plan.CreatedAt = timestamp(result.CreatedAt)
+	plan.LastUpdated = timestamp(result.UpdatedAt)
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -100,6 +128,10 @@ func (r resourceSmartList) Create(ctx context.Context, req tfsdk.CreateResourceR
 }
 
 func (r resourceSmartList) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	if !r.p.refreshable(&resp.Diagnostics) {
+		return
+	}
+
 	var state SmartList
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -107,8 +139,11 @@ func (r resourceSmartList) Read(ctx context.Context, req tfsdk.ReadResourceReque
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, state.Timeouts, "read")
+	defer cancel()
+
 	smartListID := state.ID.Value
-	smartList, err := r.p.client.GetSmartList(smartListID)
+	smartList, err := r.p.client().GetSmartList(ctx, smartListID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading smartList",
@@ -117,8 +152,19 @@ func (r resourceSmartList) Read(ctx context.Context, req tfsdk.ReadResourceReque
 		return
 	}
 
-	state.ID = types.String{Value: smartList.ID}
+	state.ID = types.String{Value: strconv.Itoa(smartList.ID)}
 	state.Name = types.String{Value: smartList.Name}
+	state.CreatedAt = timestamp(smartList.CreatedAt)
+	state.LastUpdated = timestamp(smartList.UpdatedAt)
+	if !state.Description.Null || smartList.Description != "" {
+		state.Description = types.String{Value: smartList.Description}
+	}
+	setParent(smartList.Folder, &state.Folder, &state.Program)
+	if state.Source.Null {
+		// Imported smart lists were not cloned here, so they are their own
+		// source.
+		state.Source = types.String{Value: smartListID}
+	}
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -128,6 +174,10 @@ func (r resourceSmartList) Read(ctx context.Context, req tfsdk.ReadResourceReque
 }
 
 func (r resourceSmartList) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	if !r.p.ready(&resp.Diagnostics) {
+		return
+	}
+
 	var plan SmartList
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -135,6 +185,9 @@ func (r resourceSmartList) Update(ctx context.Context, req tfsdk.UpdateResourceR
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, plan.Timeouts, "update")
+	defer cancel()
+
 	var state SmartList
 	diags = req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -143,23 +196,23 @@ func (r resourceSmartList) Update(ctx context.Context, req tfsdk.UpdateResourceR
 	}
 
 	smartList := marketo.SmartList{
-		Name: plan.Name.Value,
+		Name:        plan.Name.Value,
+		Description: plan.Description.Value,
 	}
 
 	smartListID := state.ID.Value
-	result, err := r.p.client.UpdateSmartList(smartListID, smartList)
+	result, err := r.p.client().UpdateSmartList(ctx, smartListID, smartList)
 	if err != nil {
 		resp.Diagnostics.AddError(
-			"Error update order",
-			"Could not update orderID "+smartListID+": "+err.Error(),
+			"Error updating smart list",
+			"Could not update smart list with ID "+smartListID+": "+err.Error(),
 		)
 		return
 	}
 
-	// update more fields once they can differ between result and plan.
-
-	plan.ID = types.String{Value: result.ID}
-	plan.LastUpdated = types.String{Value: string(time.Now().Format(time.RFC850))}
+	plan.ID = types.String{Value: strconv.Itoa(result.ID)}
+	plan.CreatedAt = timestamp(result.CreatedAt)
+	plan.LastUpdated = timestamp(result.UpdatedAt)
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -169,6 +222,10 @@ func (r resourceSmartList) Update(ctx context.Context, req tfsdk.UpdateResourceR
 }
 
 func (r resourceSmartList) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	if !r.p.ready(&resp.Diagnostics) {
+		return
+	}
+
 	var state SmartList
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -176,8 +233,11 @@ func (r resourceSmartList) Delete(ctx context.Context, req tfsdk.DeleteResourceR
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, state.Timeouts, "delete")
+	defer cancel()
+
 	smartListID := state.ID.Value
-	err := r.p.client.DeleteSmartList(smartListID)
+	err := r.p.client().DeleteSmartList(ctx, smartListID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting smartList",
@@ -0,0 +1,455 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/eveld/terraform-provider-marketo/marketo"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type resourceSnippetType struct{}
+
+func (r resourceSnippetType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"last_updated": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"created_at": {
+				Type:          types.StringType,
+				Computed:      true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{useStateForUnknown{}},
+			},
+			"last_applied": {
+				Type:        types.StringType,
+				Computed:    true,
+				Description: "When Terraform last wrote the snippet, used to detect changes made in Marketo.",
+			},
+			"content_hash": {
+				Type:        types.StringType,
+				Computed:    true,
+				Description: "Hash of the content of the snippet as Terraform last wrote it.",
+			},
+			"name": {
+				Type:     types.StringType,
+				Required: true,
+			},
+			"description": {
+				Type:     types.StringType,
+				Optional: true,
+			},
+			"folder": {
+				Type:          types.StringType,
+				Required:      true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{tfsdk.RequiresReplace()},
+			},
+			"html": {
+				Type:     types.StringType,
+				Optional: true,
+			},
+			"text": {
+				Type:     types.StringType,
+				Optional: true,
+			},
+			"dynamic_content": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "ID of the segmentation the snippet content varies by.",
+			},
+			"approved": {
+				Type:     types.BoolType,
+				Optional: true,
+			},
+			"status": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"timeouts": timeoutsAttribute(),
+		},
+	}, nil
+}
+
+func (r resourceSnippetType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return resourceSnippet{
+		p: p.(*provider),
+	}, nil
+}
+
+type resourceSnippet struct {
+	p *provider
+}
+
+// snippetContent turns the configured content attributes into the sections
+// the content endpoint expects, one call per section.
+func snippetContent(plan Snippet) []marketo.SnippetContent {
+	var content []marketo.SnippetContent
+	if !plan.HTML.Null {
+		content = append(content, marketo.SnippetContent{Type: "HTML", Content: plan.HTML.Value})
+	}
+	if !plan.Text.Null {
+		content = append(content, marketo.SnippetContent{Type: "Text", Content: plan.Text.Value})
+	}
+	if !plan.DynamicContent.Null {
+		content = append(content, marketo.SnippetContent{Type: "DynamicContent", Content: plan.DynamicContent.Value})
+	}
+	return content
+}
+
+// snippetHash hashes the content of a snippet as the API returns it.
+func snippetHash(contents []marketo.SnippetContent) string {
+	var parts []string
+	for _, content := range contents {
+		parts = append(parts, content.Type, content.Content)
+	}
+	return contentHash(parts...)
+}
+
+// version reads back the updatedAt and content hash of a snippet after it was
+// written.
+func (r resourceSnippet) version(ctx context.Context, snippetID string) (string, string, error) {
+	snippet, err := r.p.client().GetSnippet(ctx, snippetID)
+	if err != nil {
+		return "", "", err
+	}
+
+	contents, err := r.p.client().GetSnippetContent(ctx, snippetID)
+	if err != nil {
+		return "", "", err
+	}
+
+	return snippet.UpdatedAt, snippetHash(contents), nil
+}
+
+func (r resourceSnippet) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	if !r.p.ready(&resp.Diagnostics) {
+		return
+	}
+
+	var plan Snippet
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, plan.Timeouts, "create")
+	defer cancel()
+
+	folderID, err := strconv.Atoi(plan.Folder.Value)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			tftypes.NewAttributePath().WithAttributeName("folder"),
+			"Invalid folder",
+			"Folder must be a numeric folder ID: "+err.Error(),
+		)
+		return
+	}
+
+	snippet := marketo.Snippet{
+		Name:        plan.Name.Value,
+		Description: plan.Description.Value,
+		Folder:      marketo.FolderID{ID: folderID, Type: "Folder"},
+	}
+
+	result, err := r.p.client().CreateSnippet(ctx, snippet)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating snippet",
+			"Could not create snippet, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	snippetID := strconv.Itoa(result.ID)
+	plan.ID = types.String{Value: snippetID}
+	plan.CreatedAt = timestamp(result.CreatedAt)
+	for _, content := range snippetContent(plan) {
+		err = r.p.client().UpdateSnippetContent(ctx, snippetID, content)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating snippet",
+				"Could not set "+content.Type+" content of snippet with ID "+snippetID+": "+err.Error(),
+			)
+			keepCreated(ctx, resp, plan)
+			return
+		}
+	}
+
+	status := result.Status
+	if plan.Approved.Value {
+		err = r.p.client().ApproveSnippet(ctx, snippetID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error approving snippet",
+				"Could not approve snippet with ID "+snippetID+": "+err.Error(),
+			)
+			keepCreated(ctx, resp, plan)
+			return
+		}
+		status = "approved"
+	}
+
+	plan.Status = types.String{Value: status}
+	updatedAt, hash, err := r.version(ctx, snippetID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating snippet",
+			"Could not read back snippet with ID "+snippetID+": "+err.Error(),
+		)
+		keepCreated(ctx, resp, plan)
+		return
+	}
+
+	plan.LastUpdated = timestamp(updatedAt)
+	plan.LastApplied = plan.LastUpdated
+	plan.ContentHash = types.String{Value: hash}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r resourceSnippet) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	if !r.p.refreshable(&resp.Diagnostics) {
+		return
+	}
+
+	var state Snippet
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, state.Timeouts, "read")
+	defer cancel()
+
+	// Imported snippets only have an ID.
+	imported := state.Name.Null
+
+	snippetID := state.ID.Value
+	snippet, err := r.p.client().GetSnippet(ctx, snippetID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading snippet",
+			"Could not read snippet with ID "+snippetID+": "+err.Error(),
+		)
+		return
+	}
+
+	contents, err := r.p.client().GetSnippetContent(ctx, snippetID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading snippet",
+			"Could not read content of snippet with ID "+snippetID+": "+err.Error(),
+		)
+		return
+	}
+
+	hash := snippetHash(contents)
+	if r.p.keepOnDrift(&resp.Diagnostics, "snippet", snippetID, state.LastApplied, state.ContentHash, snippet.UpdatedAt, hash) {
+		state.LastUpdated = timestamp(snippet.UpdatedAt)
+		diags = resp.State.Set(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Marketo derives a text version from the HTML when none is given, so
+	// only sections that are managed here are refreshed. Imported snippets
+	// take over the HTML and dynamic content.
+	for _, content := range contents {
+		switch {
+		case content.Type == "HTML" && (!state.HTML.Null || imported):
+			state.HTML = types.String{Value: content.Content}
+		case content.Type == "Text" && !state.Text.Null:
+			state.Text = types.String{Value: content.Content}
+		case content.Type == "DynamicContent" && (!state.DynamicContent.Null || imported):
+			state.DynamicContent = types.String{Value: content.Content}
+		}
+	}
+
+	state.ID = types.String{Value: strconv.Itoa(snippet.ID)}
+	state.Name = types.String{Value: snippet.Name}
+	state.CreatedAt = timestamp(snippet.CreatedAt)
+	state.LastUpdated = timestamp(snippet.UpdatedAt)
+	if !state.Description.Null || snippet.Description != "" {
+		state.Description = types.String{Value: snippet.Description}
+	}
+	state.Folder = types.String{Value: strconv.Itoa(snippet.Folder.ID)}
+	state.Status = types.String{Value: snippet.Status}
+	if !state.Approved.Null || (imported && snippet.Status == "approved") {
+		state.Approved = types.Bool{Value: snippet.Status == "approved"}
+	}
+
+	// Imported snippets and state written before drift was tracked take the
+	// current version as the one Terraform last wrote.
+	if state.LastApplied.Null {
+		state.LastApplied = state.LastUpdated
+		state.ContentHash = types.String{Value: hash}
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r resourceSnippet) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	if !r.p.ready(&resp.Diagnostics) {
+		return
+	}
+
+	var plan Snippet
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, plan.Timeouts, "update")
+	defer cancel()
+
+	var state Snippet
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	snippet := marketo.Snippet{
+		Name:        plan.Name.Value,
+		Description: plan.Description.Value,
+	}
+
+	snippetID := state.ID.Value
+	result, err := r.p.client().UpdateSnippet(ctx, snippetID, snippet)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating snippet",
+			"Could not update snippet with ID "+snippetID+": "+err.Error(),
+		)
+		return
+	}
+
+	for _, content := range snippetContent(plan) {
+		err = r.p.client().UpdateSnippetContent(ctx, snippetID, content)
+		if err != nil {
+			// Leave the approved version untouched rather than a half
+			// written draft.
+			resp.Diagnostics.AddError(
+				"Error updating snippet",
+				"Could not set "+content.Type+" content of snippet with ID "+snippetID+": "+err.Error(),
+			)
+
+			err = r.p.client().DiscardSnippetDraft(ctx, snippetID)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Error updating snippet",
+					"Could not discard the draft of snippet with ID "+snippetID+", it holds partially written content: "+err.Error(),
+				)
+			}
+			return
+		}
+	}
+
+	status := result.Status
+	if plan.Approved.Value {
+		err = r.p.client().ApproveSnippet(ctx, snippetID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error approving snippet",
+				"Could not approve snippet with ID "+snippetID+": "+err.Error(),
+			)
+			return
+		}
+		status = "approved"
+	} else if state.Approved.Value {
+		err = r.p.client().UnapproveSnippet(ctx, snippetID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error unapproving snippet",
+				"Could not unapprove snippet with ID "+snippetID+": "+err.Error(),
+			)
+			return
+		}
+		status = "draft"
+	}
+
+	updatedAt, hash, err := r.version(ctx, snippetID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating snippet",
+			"Could not read back snippet with ID "+snippetID+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.String{Value: strconv.Itoa(result.ID)}
+	plan.Status = types.String{Value: status}
+	plan.CreatedAt = timestamp(result.CreatedAt)
+	plan.LastUpdated = timestamp(updatedAt)
+	plan.LastApplied = plan.LastUpdated
+	plan.ContentHash = types.String{Value: hash}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r resourceSnippet) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	if !r.p.ready(&resp.Diagnostics) {
+		return
+	}
+
+	var state Snippet
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, state.Timeouts, "delete")
+	defer cancel()
+
+	snippetID := state.ID.Value
+	if state.Approved.Value {
+		// Approved snippets have to be unapproved before they can be deleted.
+		err := r.p.client().UnapproveSnippet(ctx, snippetID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error deleting snippet",
+				"Could not unapprove snippet with ID "+snippetID+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	err := r.p.client().DeleteSnippet(ctx, snippetID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting snippet",
+			"Could not delete snippet with ID "+snippetID+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r resourceSnippet) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	tfsdk.ResourceImportStatePassthroughID(ctx, tftypes.NewAttributePath().WithAttributeName("id"), req, resp)
+}
@@ -0,0 +1,267 @@
+package provider
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type resourceStaticListMembershipType struct{}
+
+func (r resourceStaticListMembershipType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: "Manages the exact set of leads in a static list. Leads that are not declared are removed.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"last_updated": {
+				Type:        types.StringType,
+				Computed:    true,
+				Description: "When the static list was last updated.",
+			},
+			"created_at": {
+				Type:          types.StringType,
+				Computed:      true,
+				Description:   "When the static list was created.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{useStateForUnknown{}},
+			},
+			"list": {
+				Type:          types.StringType,
+				Required:      true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{tfsdk.RequiresReplace()},
+			},
+			"leads": {
+				Type: types.SetType{
+					ElemType: types.Int64Type,
+				},
+				Required: true,
+			},
+			"timeouts": timeoutsAttribute(),
+		},
+	}, nil
+}
+
+func (r resourceStaticListMembershipType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return resourceStaticListMembership{
+		p: p.(*provider),
+	}, nil
+}
+
+type resourceStaticListMembership struct {
+	p *provider
+}
+
+// syncLeads adds and removes leads until the members of the list match leads.
+func (r resourceStaticListMembership) syncLeads(ctx context.Context, listID string, leads []int64) error {
+	current, err := r.p.client().GetListLeads(ctx, listID)
+	if err != nil {
+		return err
+	}
+
+	desired := map[int]bool{}
+	for _, id := range leads {
+		desired[int(id)] = true
+	}
+
+	var remove []int
+	for _, id := range current {
+		if desired[id] {
+			delete(desired, id)
+			continue
+		}
+		remove = append(remove, id)
+	}
+
+	var add []int
+	for id := range desired {
+		add = append(add, id)
+	}
+	sort.Ints(add)
+
+	err = r.p.client().RemoveLeadsFromList(ctx, listID, remove)
+	if err != nil {
+		return err
+	}
+
+	return r.p.client().AddLeadsToList(ctx, listID, add)
+}
+
+func (r resourceStaticListMembership) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	if !r.p.ready(&resp.Diagnostics) {
+		return
+	}
+
+	var plan StaticListMembership
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, plan.Timeouts, "create")
+	defer cancel()
+
+	listID := plan.List.Value
+	err := r.syncLeads(ctx, listID, plan.Leads)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating static list membership",
+			"Could not add leads to static list with ID "+listID+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.String{Value: listID}
+	plan.CreatedAt, plan.LastUpdated, err = listTimestamps(ctx, r.p.client(), listID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating static list membership",
+			"Could not read static list with ID "+listID+": "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r resourceStaticListMembership) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	if !r.p.refreshable(&resp.Diagnostics) {
+		return
+	}
+
+	var state StaticListMembership
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, state.Timeouts, "read")
+	defer cancel()
+
+	listID := state.ID.Value
+	leads, err := r.p.client().GetListLeads(ctx, listID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading static list membership",
+			"Could not read leads of static list with ID "+listID+": "+err.Error(),
+		)
+		return
+	}
+
+	state.List = types.String{Value: listID}
+	state.CreatedAt, state.LastUpdated, err = listTimestamps(ctx, r.p.client(), listID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading static list membership",
+			"Could not read static list with ID "+listID+": "+err.Error(),
+		)
+		return
+	}
+	state.Leads = make([]int64, 0, len(leads))
+	for _, id := range leads {
+		state.Leads = append(state.Leads, int64(id))
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r resourceStaticListMembership) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	if !r.p.ready(&resp.Diagnostics) {
+		return
+	}
+
+	var plan StaticListMembership
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, plan.Timeouts, "update")
+	defer cancel()
+
+	var state StaticListMembership
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	listID := state.ID.Value
+	err := r.syncLeads(ctx, listID, plan.Leads)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating static list membership",
+			"Could not update leads of static list with ID "+listID+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = state.ID
+	plan.CreatedAt, plan.LastUpdated, err = listTimestamps(ctx, r.p.client(), listID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating static list membership",
+			"Could not read static list with ID "+listID+": "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r resourceStaticListMembership) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	if !r.p.ready(&resp.Diagnostics) {
+		return
+	}
+
+	var state StaticListMembership
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, state.Timeouts, "delete")
+	defer cancel()
+
+	leads := make([]int, 0, len(state.Leads))
+	for _, id := range state.Leads {
+		leads = append(leads, int(id))
+	}
+
+	listID := state.ID.Value
+	err := r.p.client().RemoveLeadsFromList(ctx, listID, leads)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting static list membership",
+			"Could not remove leads from static list with ID "+listID+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r resourceStaticListMembership) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	tfsdk.ResourceImportStatePassthroughID(ctx, tftypes.NewAttributePath().WithAttributeName("id"), req, resp)
+}
@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-log/tfsdklog"
+)
+
+// NewServer returns the protocol server of the provider. It gives each of the
+// calls that reach the Marketo API a root logger, which the framework version
+// in use does not set up, so that tflog entries end up in the Terraform log.
+func NewServer() tfprotov6.ProviderServer {
+	return loggingServer{tfsdk.NewProtocol6Server(New())}
+}
+
+type loggingServer struct {
+	tfprotov6.ProviderServer
+}
+
+// withLogger writes JSON entries to the stderr Terraform reads plugin logs
+// from. TF_LOG_PROVIDER can lower the level further than TF_LOG does.
+func withLogger(ctx context.Context) context.Context {
+	return tfsdklog.NewRootProviderLogger(ctx,
+		tfsdklog.WithLogName("marketo"),
+		tfsdklog.WithLevelFromEnv("TF_LOG_PROVIDER"),
+		tfsdklog.WithStderrFromInit(),
+	)
+}
+
+func (s loggingServer) ConfigureProvider(ctx context.Context, req *tfprotov6.ConfigureProviderRequest) (*tfprotov6.ConfigureProviderResponse, error) {
+	return s.ProviderServer.ConfigureProvider(withLogger(ctx), req)
+}
+
+func (s loggingServer) ReadResource(ctx context.Context, req *tfprotov6.ReadResourceRequest) (*tfprotov6.ReadResourceResponse, error) {
+	return s.ProviderServer.ReadResource(withLogger(ctx), req)
+}
+
+func (s loggingServer) PlanResourceChange(ctx context.Context, req *tfprotov6.PlanResourceChangeRequest) (*tfprotov6.PlanResourceChangeResponse, error) {
+	return s.ProviderServer.PlanResourceChange(withLogger(ctx), req)
+}
+
+func (s loggingServer) ApplyResourceChange(ctx context.Context, req *tfprotov6.ApplyResourceChangeRequest) (*tfprotov6.ApplyResourceChangeResponse, error) {
+	return s.ProviderServer.ApplyResourceChange(withLogger(ctx), req)
+}
+
+func (s loggingServer) ImportResourceState(ctx context.Context, req *tfprotov6.ImportResourceStateRequest) (*tfprotov6.ImportResourceStateResponse, error) {
+	return s.ProviderServer.ImportResourceState(withLogger(ctx), req)
+}
+
+func (s loggingServer) ReadDataSource(ctx context.Context, req *tfprotov6.ReadDataSourceRequest) (*tfprotov6.ReadDataSourceResponse, error) {
+	return s.ProviderServer.ReadDataSource(withLogger(ctx), req)
+}
@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Timeouts bounds each operation on a resource as a whole, including token
+// refreshes and every request it takes.
+type Timeouts struct {
+	Create types.String `tfsdk:"create"`
+	Read   types.String `tfsdk:"read"`
+	Update types.String `tfsdk:"update"`
+	Delete types.String `tfsdk:"delete"`
+}
+
+// Timeouts of operations without a timeouts block. Creates and updates are
+// longer, as they include clones, uploads and approvals.
+const (
+	defaultCreateTimeout = 20 * time.Minute
+	defaultReadTimeout   = 5 * time.Minute
+	defaultUpdateTimeout = 20 * time.Minute
+	defaultDeleteTimeout = 5 * time.Minute
+)
+
+func timeoutsAttribute() tfsdk.Attribute {
+	timeout := func(description string) tfsdk.Attribute {
+		return tfsdk.Attribute{
+			Type:        types.StringType,
+			Optional:    true,
+			Description: description,
+			Validators:  []tfsdk.AttributeValidator{duration{}},
+		}
+	}
+
+	return tfsdk.Attribute{
+		Optional:    true,
+		Description: "Timeouts of the operations on this resource, for example \"30s\" or \"10m\".",
+		Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+			"create": timeout("Defaults to 20m."),
+			"read":   timeout("Defaults to 5m."),
+			"update": timeout("Defaults to 20m."),
+			"delete": timeout("Defaults to 5m."),
+		}),
+	}
+}
+
+// timeoutContext returns ctx with the deadline of the given operation, which
+// is one of create, read, update or delete. The client stops retrying and
+// returns an error once the deadline passes.
+func timeoutContext(ctx context.Context, timeouts *Timeouts, operation string) (context.Context, context.CancelFunc) {
+	var timeout types.String
+	var d time.Duration
+	switch operation {
+	case "create":
+		d = defaultCreateTimeout
+		if timeouts != nil {
+			timeout = timeouts.Create
+		}
+	case "read":
+		d = defaultReadTimeout
+		if timeouts != nil {
+			timeout = timeouts.Read
+		}
+	case "update":
+		d = defaultUpdateTimeout
+		if timeouts != nil {
+			timeout = timeouts.Update
+		}
+	case "delete":
+		d = defaultDeleteTimeout
+		if timeouts != nil {
+			timeout = timeouts.Delete
+		}
+	}
+
+	if !timeout.Null && !timeout.Unknown && timeout.Value != "" {
+		configured, err := time.ParseDuration(timeout.Value)
+		if err == nil {
+			d = configured
+		}
+	}
+	return context.WithTimeout(ctx, d)
+}
@@ -0,0 +1,153 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/eveld/terraform-provider-marketo/marketo"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// stringOneOf validates that a string attribute holds one of the given values.
+type stringOneOf []string
+
+func (v stringOneOf) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be one of: %s", strings.Join(v, ", "))
+}
+
+func (v stringOneOf) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v stringOneOf) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	value, ok := req.AttributeConfig.(types.String)
+	if !ok || value.Null || value.Unknown {
+		return
+	}
+
+	for _, allowed := range v {
+		if value.Value == allowed {
+			return
+		}
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		req.AttributePath,
+		"Invalid value",
+		fmt.Sprintf("%q is not valid, %s.", value.Value, v.Description(ctx)),
+	)
+}
+
+// stringOneOfFold is stringOneOf ignoring case.
+type stringOneOfFold []string
+
+func (v stringOneOfFold) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be one of, ignoring case: %s", strings.Join(v, ", "))
+}
+
+func (v stringOneOfFold) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v stringOneOfFold) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	value, ok := req.AttributeConfig.(types.String)
+	if !ok || value.Null || value.Unknown {
+		return
+	}
+
+	for _, allowed := range v {
+		if strings.EqualFold(value.Value, allowed) {
+			return
+		}
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		req.AttributePath,
+		"Invalid value",
+		fmt.Sprintf("%q is not valid, %s.", value.Value, v.Description(ctx)),
+	)
+}
+
+// rfc3339 validates that a string attribute holds an RFC3339 timestamp.
+type rfc3339 struct{}
+
+func (v rfc3339) Description(_ context.Context) string {
+	return "value must be an RFC3339 timestamp, for example 2022-02-17T09:00:00Z"
+}
+
+func (v rfc3339) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v rfc3339) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	value, ok := req.AttributeConfig.(types.String)
+	if !ok || value.Null || value.Unknown {
+		return
+	}
+
+	_, err := time.Parse(time.RFC3339, value.Value)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.AttributePath,
+			"Invalid value",
+			fmt.Sprintf("%q is not valid, %s.", value.Value, v.Description(ctx)),
+		)
+	}
+}
+
+// duration validates that a string attribute holds a positive duration.
+type duration struct{}
+
+func (v duration) Description(_ context.Context) string {
+	return "value must be a positive duration, for example 30s or 10m"
+}
+
+func (v duration) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v duration) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	value, ok := req.AttributeConfig.(types.String)
+	if !ok || value.Null || value.Unknown {
+		return
+	}
+
+	d, err := time.ParseDuration(value.Value)
+	if err != nil || d <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.AttributePath,
+			"Invalid value",
+			fmt.Sprintf("%q is not valid, %s.", value.Value, v.Description(ctx)),
+		)
+	}
+}
+
+// munchkinID validates that a string attribute holds a Munchkin ID.
+type munchkinID struct{}
+
+func (v munchkinID) Description(_ context.Context) string {
+	return "value must be a Munchkin ID, for example 123-ABC-456"
+}
+
+func (v munchkinID) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v munchkinID) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	value, ok := req.AttributeConfig.(types.String)
+	if !ok || value.Null || value.Unknown {
+		return
+	}
+
+	_, err := marketo.MunchkinEndpoint(value.Value)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.AttributePath,
+			"Invalid value",
+			fmt.Sprintf("%q is not valid, %s.", value.Value, v.Description(ctx)),
+		)
+	}
+}
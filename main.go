@@ -1,14 +1,10 @@
 package main
 
 import (
-	"context"
-
 	"github.com/eveld/terraform-provider-marketo/internal/provider"
-	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6server"
 )
 
 func main() {
-	tfsdk.Serve(context.Background(), provider.New, tfsdk.ServeOpts{
-		Name: "marketo",
-	})
+	tf6server.Serve("marketo", provider.NewServer)
 }
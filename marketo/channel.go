@@ -0,0 +1,51 @@
+package marketo
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+)
+
+type Channel struct {
+	ID                    int    `json:"id"`
+	Name                  string `json:"name"`
+	ApplicableProgramType string `json:"applicableProgramType"`
+	CreatedAt             string `json:"createdAt"`
+	UpdatedAt             string `json:"updatedAt"`
+}
+
+func (c *Client) GetChannelByName(ctx context.Context, name string) (*Channel, error) {
+	query := url.Values{}
+	query.Set("name", name)
+
+	var result []Channel
+	err := c.get(ctx, "/asset/v1/channel/byName.json", query, &result)
+	if err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, ErrNotFound
+	}
+	return &result[0], nil
+}
+
+func (c *Client) ListChannels(ctx context.Context) ([]Channel, error) {
+	var channels []Channel
+
+	query := url.Values{}
+	query.Set("maxReturn", "200")
+	for offset := 0; ; offset += 200 {
+		query.Set("offset", strconv.Itoa(offset))
+
+		var result []Channel
+		err := c.get(ctx, "/asset/v1/channels.json", query, &result)
+		if err != nil {
+			return nil, err
+		}
+
+		channels = append(channels, result...)
+		if len(result) < 200 {
+			return channels, nil
+		}
+	}
+}
@@ -1,22 +1,390 @@
 package marketo
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
 	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
 	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 type Client struct {
-	ID         string
-	Secret     string
-	URL        string
-	HTTPClient *http.Client
+	ID          string
+	Secret      string
+	URL         string
+	IdentityURL string
+	HTTPClient  *http.Client
+
+	// RequestTimeout bounds every single HTTP request, including the token
+	// requests. Deadlines on the context passed to the methods of the client
+	// bound whole operations, retries included.
+	RequestTimeout time.Duration
+
+	// Workspace is used for assets that do not name a workspace of their
+	// own. An empty string is the Default workspace.
+	Workspace string
+
+	// mu guards the token, which requests running concurrently share.
+	mu          sync.Mutex
+	token       string
+	tokenExpiry time.Time
+	user        string
 }
 
+// DefaultRequestTimeout is the RequestTimeout of new clients.
+const DefaultRequestTimeout = 30 * time.Second
+
+// pollInterval is the time between two checks of poll.
+var pollInterval = 2 * time.Second
+
 func NewClient(url string, id string, secret string) (*Client, error) {
+	url = strings.TrimSuffix(url, "/")
+
 	return &Client{
-		URL:        url,
-		ID:         id,
-		Secret:     secret,
-		HTTPClient: &http.Client{Timeout: 10 * time.Second},
+		URL:            url,
+		IdentityURL:    strings.TrimSuffix(url, "/rest") + "/identity",
+		ID:             id,
+		Secret:         secret,
+		HTTPClient:     &http.Client{},
+		RequestTimeout: DefaultRequestTimeout,
 	}, nil
 }
+
+var munchkinIDPattern = regexp.MustCompile(`^[0-9]{3}-[A-Za-z]{3}-[0-9]{3}$`)
+
+// MunchkinEndpoint returns the REST API endpoint of the instance with the
+// given Munchkin ID, such as 123-ABC-456. NewClient derives the identity
+// endpoint from it.
+func MunchkinEndpoint(munchkinID string) (string, error) {
+	if !munchkinIDPattern.MatchString(munchkinID) {
+		return "", fmt.Errorf("%q is not a Munchkin ID, expected the form 123-ABC-456", munchkinID)
+	}
+	return "https://" + strings.ToUpper(munchkinID) + ".mktorest.com/rest", nil
+}
+
+// ErrNotFound is returned when Marketo reports success but no asset matched.
+var ErrNotFound = errors.New("asset not found")
+
+// Error is a single entry of the errors array Marketo returns alongside
+// "success": false.
+type Error struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e Error) Error() string {
+	return e.Code + ": " + e.Message
+}
+
+// response is the envelope that wraps every REST API result.
+type response struct {
+	RequestID     string          `json:"requestId"`
+	Success       bool            `json:"success"`
+	Errors        []Error         `json:"errors"`
+	Warnings      []string        `json:"warnings"`
+	NextPageToken string          `json:"nextPageToken"`
+	MoreResult    bool            `json:"moreResult"`
+	Result        json.RawMessage `json:"result"`
+}
+
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+	ExpiresIn   int    `json:"expires_in"`
+	Scope       string `json:"scope"`
+}
+
+// authenticate fetches a new token. The caller holds mu. The credentials are
+// sent in the body rather than the query, so that they do not end up in
+// errors that include the URL.
+func (c *Client) authenticate(ctx context.Context) error {
+	form := url.Values{}
+	form.Set("grant_type", "client_credentials")
+	form.Set("client_id", c.ID)
+	form.Set("client_secret", c.Secret)
+
+	ctx, cancel := c.requestContext(c.logContext(ctx, ""))
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.IdentityURL+"/oauth/token", strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	fields := requestFields(req, 1)
+	tflog.Trace(ctx, "Requesting Marketo access token", fields)
+	start := time.Now()
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		logResponse(ctx, fields, start, nil, nil, err)
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		err = fmt.Errorf("unable to authenticate: %s", resp.Status)
+		logResponse(ctx, fields, start, resp, nil, err)
+		return err
+	}
+
+	var token tokenResponse
+	err = json.NewDecoder(resp.Body).Decode(&token)
+	logResponse(ctx, fields, start, resp, nil, err)
+	if err != nil {
+		return err
+	}
+
+	c.token = token.AccessToken
+	c.tokenExpiry = time.Now().Add(time.Duration(token.ExpiresIn) * time.Second)
+	c.user = token.Scope
+	return nil
+}
+
+// accessToken returns the current token, and fetches a new one first when
+// there is none or it expired.
+func (c *Client) accessToken(ctx context.Context) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.token == "" || time.Now().After(c.tokenExpiry) {
+		err := c.authenticate(ctx)
+		if err != nil {
+			return "", err
+		}
+	}
+	return c.token, nil
+}
+
+// expireToken drops token, unless a concurrent request already replaced it.
+func (c *Client) expireToken(token string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.token == token {
+		c.token = ""
+	}
+}
+
+// do sends a request to the REST API and decodes the result array into
+// result, which may be nil when the caller does not need it. Expired or
+// invalid tokens are refreshed once before giving up.
+func (c *Client) do(ctx context.Context, method string, path string, contentType string, body func() io.Reader, result interface{}) (*response, error) {
+	var envelope *response
+	for attempt := 0; attempt < 2; attempt++ {
+		token, err := c.accessToken(ctx)
+		if err != nil {
+			return nil, err
+		}
+		logCtx := c.logContext(ctx, token)
+
+		var reader io.Reader
+		if body != nil {
+			reader = body()
+		}
+
+		req, err := http.NewRequestWithContext(logCtx, method, c.URL+path, reader)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+		if contentType != "" {
+			req.Header.Set("Content-Type", contentType)
+		}
+
+		envelope, err = c.send(req, attempt+1)
+		if err != nil {
+			return nil, err
+		}
+
+		if envelope.Success || !tokenExpired(envelope.Errors) {
+			break
+		}
+		tflog.Debug(logCtx, "Marketo access token expired, retrying with a new token", map[string]interface{}{
+			"method":     method,
+			"request_id": envelope.RequestID,
+		})
+		c.expireToken(token)
+	}
+
+	if !envelope.Success {
+		if len(envelope.Errors) > 0 {
+			return envelope, envelope.Errors[0]
+		}
+		return envelope, fmt.Errorf("request %s failed without errors", envelope.RequestID)
+	}
+
+	if result == nil || len(envelope.Result) == 0 {
+		return envelope, nil
+	}
+	return envelope, json.Unmarshal(envelope.Result, result)
+}
+
+// send sends req and decodes the envelope of the response. attempt counts
+// the tries of do, for the log.
+func (c *Client) send(req *http.Request, attempt int) (*response, error) {
+	ctx, cancel := c.requestContext(req.Context())
+	defer cancel()
+
+	fields := requestFields(req, attempt)
+	tflog.Trace(ctx, "Sending Marketo API request", fields)
+	start := time.Now()
+
+	resp, err := c.HTTPClient.Do(req.WithContext(ctx))
+	if err != nil {
+		logResponse(ctx, fields, start, nil, nil, err)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		err = fmt.Errorf("%s %s: %s", req.Method, req.URL.Path, resp.Status)
+		logResponse(ctx, fields, start, resp, nil, err)
+		return nil, err
+	}
+
+	var envelope response
+	err = json.NewDecoder(resp.Body).Decode(&envelope)
+	if err != nil {
+		logResponse(ctx, fields, start, resp, nil, err)
+		return nil, err
+	}
+
+	logResponse(ctx, fields, start, resp, &envelope, nil)
+	return &envelope, nil
+}
+
+// poll calls done until it reports true or fails. Approvals can complete
+// after the request that started them returned, so they are polled until the
+// deadline of ctx.
+func poll(ctx context.Context, what string, done func() (bool, error)) error {
+	for {
+		ok, err := done()
+		if err != nil || ok {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("waiting for %s: %w", what, ctx.Err())
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
+func (c *Client) get(ctx context.Context, path string, query url.Values, result interface{}) error {
+	_, err := c.getPage(ctx, path, query, result)
+	return err
+}
+
+// getPage is get for endpoints that page with nextPageToken. It returns the
+// token of the next page, or an empty string on the last page.
+func (c *Client) getPage(ctx context.Context, path string, query url.Values, result interface{}) (string, error) {
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+
+	envelope, err := c.do(ctx, http.MethodGet, path, "", nil, result)
+	if err != nil {
+		return "", err
+	}
+	return envelope.NextPageToken, nil
+}
+
+func (c *Client) post(ctx context.Context, path string, form url.Values, result interface{}) error {
+	body := func() io.Reader {
+		return strings.NewReader(form.Encode())
+	}
+	_, err := c.do(ctx, http.MethodPost, path, "application/x-www-form-urlencoded", body, result)
+	return err
+}
+
+// sendJSON sends input as a JSON body, as the lead database endpoints expect.
+func (c *Client) sendJSON(ctx context.Context, method string, path string, input interface{}, result interface{}) error {
+	payload, err := json.Marshal(input)
+	if err != nil {
+		return err
+	}
+
+	body := func() io.Reader {
+		return bytes.NewReader(payload)
+	}
+	_, err = c.do(ctx, method, path, "application/json", body, result)
+	return err
+}
+
+// postMultipart uploads content as the "file" part of a multipart form,
+// alongside the regular form fields.
+func (c *Client) postMultipart(ctx context.Context, path string, fields url.Values, filename string, content []byte, result interface{}) error {
+	var buf bytes.Buffer
+	writer := multipart.NewWriter(&buf)
+
+	for key, values := range fields {
+		for _, value := range values {
+			err := writer.WriteField(key, value)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	part, err := writer.CreateFormFile("file", filename)
+	if err != nil {
+		return err
+	}
+
+	_, err = part.Write(content)
+	if err != nil {
+		return err
+	}
+
+	err = writer.Close()
+	if err != nil {
+		return err
+	}
+
+	payload := buf.Bytes()
+	body := func() io.Reader {
+		return bytes.NewReader(payload)
+	}
+	_, err = c.do(ctx, http.MethodPost, path, writer.FormDataContentType(), body, result)
+	return err
+}
+
+// requestContext bounds a single request by RequestTimeout, within the
+// deadline ctx already has for the operation as a whole.
+func (c *Client) requestContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if c.RequestTimeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, c.RequestTimeout)
+}
+
+func (c *Client) workspace(workspace string) string {
+	if workspace != "" {
+		return workspace
+	}
+	if c.Workspace != "" {
+		return c.Workspace
+	}
+	return "Default"
+}
+
+func tokenExpired(errs []Error) bool {
+	for _, e := range errs {
+		if e.Code == "601" || e.Code == "602" {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,287 @@
+package marketo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newTestClient returns a client for an instance served by handler. Tokens
+// are numbered, the first one handed out is "token-1".
+func newTestClient(t *testing.T, handler http.HandlerFunc) (*Client, *int32) {
+	t.Helper()
+
+	var tokens int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("/identity/oauth/token", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.RawQuery != "" {
+			t.Errorf("token requested with %s ?%s, want the credentials in a POST body", r.Method, r.URL.RawQuery)
+		}
+		if r.PostFormValue("client_id") != "id" || r.PostFormValue("client_secret") == "" {
+			t.Errorf("unexpected credentials %v", r.PostForm)
+		}
+
+		n := atomic.AddInt32(&tokens, 1)
+		fmt.Fprintf(w, `{"access_token": "token-%d", "expires_in": 3600, "scope": "api@example.com"}`, n)
+	})
+	mux.HandleFunc("/rest/", handler)
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	client, err := NewClient(server.URL+"/rest", "id", "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client, &tokens
+}
+
+// writeResult writes a successful response with the given result.
+func writeResult(t *testing.T, w http.ResponseWriter, result interface{}, nextPageToken string) {
+	t.Helper()
+
+	raw, err := json.Marshal(result)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = json.NewEncoder(w).Encode(response{
+		RequestID:     "request",
+		Success:       true,
+		NextPageToken: nextPageToken,
+		Result:        raw,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func writeError(w http.ResponseWriter, code string) {
+	fmt.Fprintf(w, `{"requestId": "request", "success": false, "errors": [{"code": %q, "message": "failed"}]}`, code)
+}
+
+func TestTokenRefreshedOnExpiry(t *testing.T) {
+	for _, code := range []string{"601", "602"} {
+		t.Run(code, func(t *testing.T) {
+			client, tokens := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.Header.Get("Authorization") == "Bearer token-1" {
+					writeError(w, code)
+					return
+				}
+				writeResult(t, w, []Channel{{ID: 1, Name: "Webinar"}}, "")
+			})
+
+			channel, err := client.GetChannelByName(context.Background(), "Webinar")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if channel.ID != 1 {
+				t.Errorf("got channel %d, want 1", channel.ID)
+			}
+			if *tokens != 2 {
+				t.Errorf("got %d token requests, want 2", *tokens)
+			}
+		})
+	}
+}
+
+func TestTokenRefreshedOnce(t *testing.T) {
+	var requests int32
+	client, tokens := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		writeError(w, "602")
+	})
+
+	_, err := client.GetChannelByName(context.Background(), "Webinar")
+
+	var apiErr Error
+	if !errors.As(err, &apiErr) || apiErr.Code != "602" {
+		t.Fatalf("got error %v, want code 602", err)
+	}
+	if requests != 2 || *tokens != 2 {
+		t.Errorf("got %d requests with %d tokens, want 2 of each", requests, *tokens)
+	}
+}
+
+func TestOtherErrorsNotRetried(t *testing.T) {
+	var requests int32
+	client, tokens := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		writeError(w, "610")
+	})
+
+	_, err := client.GetChannelByName(context.Background(), "Webinar")
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if requests != 1 || *tokens != 1 {
+		t.Errorf("got %d requests with %d tokens, want 1 of each", requests, *tokens)
+	}
+}
+
+func TestTokenReused(t *testing.T) {
+	client, tokens := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		writeResult(t, w, []Channel{{ID: 1}}, "")
+	})
+
+	for i := 0; i < 3; i++ {
+		_, err := client.GetChannelByName(context.Background(), "Webinar")
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if *tokens != 1 {
+		t.Errorf("got %d token requests, want 1", *tokens)
+	}
+}
+
+func TestListChannelsPagesByOffset(t *testing.T) {
+	var offsets []string
+	client, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		offset := r.URL.Query().Get("offset")
+		offsets = append(offsets, offset)
+
+		n := 200
+		if offset == "200" {
+			n = 3
+		}
+		channels := make([]Channel, n)
+		for i := range channels {
+			channels[i].ID = i
+		}
+		writeResult(t, w, channels, "")
+	})
+
+	channels, err := client.ListChannels(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(channels) != 203 {
+		t.Errorf("got %d channels, want 203", len(channels))
+	}
+	if strings.Join(offsets, ",") != "0,200" {
+		t.Errorf("got offsets %v, want 0 and 200", offsets)
+	}
+}
+
+func TestGetListLeadsPagesByToken(t *testing.T) {
+	pages := map[string]struct {
+		leads []leadID
+		next  string
+	}{
+		"":       {[]leadID{{ID: 1}, {ID: 2}}, "page-2"},
+		"page-2": {[]leadID{{ID: 3}}, "page-3"},
+		"page-3": {nil, ""},
+	}
+
+	client, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/v1/lists/1001/leads.json" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		page := pages[r.URL.Query().Get("nextPageToken")]
+		writeResult(t, w, page.leads, page.next)
+	})
+
+	leads, err := client.GetListLeads(context.Background(), "1001")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	for _, lead := range leads {
+		got = append(got, strconv.Itoa(lead))
+	}
+	if strings.Join(got, ",") != "1,2,3" {
+		t.Errorf("got leads %v, want 1, 2 and 3", leads)
+	}
+}
+
+func TestApproveSnippetPolls(t *testing.T) {
+	defer func(interval time.Duration) { pollInterval = interval }(pollInterval)
+	pollInterval = time.Millisecond
+
+	var reads int32
+	client, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rest/asset/v1/snippet/7/approveDraft.json":
+			writeResult(t, w, []Snippet{{ID: 7}}, "")
+		case "/rest/asset/v1/snippet/7.json":
+			status := "draft"
+			if atomic.AddInt32(&reads, 1) >= 3 {
+				status = "approved"
+			}
+			writeResult(t, w, []Snippet{{ID: 7, Status: status}}, "")
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	})
+
+	err := client.ApproveSnippet(context.Background(), "7")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reads != 3 {
+		t.Errorf("got %d reads, want 3", reads)
+	}
+}
+
+func TestApproveSnippetTimesOut(t *testing.T) {
+	defer func(interval time.Duration) { pollInterval = interval }(pollInterval)
+	pollInterval = time.Millisecond
+
+	client, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		writeResult(t, w, []Snippet{{ID: 7, Status: "draft"}}, "")
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	err := client.ApproveSnippet(ctx, "7")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("got error %v, want the deadline to be exceeded", err)
+	}
+}
+
+func TestMunchkinEndpoint(t *testing.T) {
+	endpoint, err := MunchkinEndpoint("123-abc-456")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if endpoint != "https://123-ABC-456.mktorest.com/rest" {
+		t.Errorf("got endpoint %s", endpoint)
+	}
+
+	_, err = MunchkinEndpoint("https://123-ABC-456.mktorest.com")
+	if err == nil {
+		t.Error("expected an error for a URL")
+	}
+}
+
+type failingTransport struct{}
+
+func (failingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return nil, errors.New("connection refused")
+}
+
+func TestTokenErrorsLeaveOutSecret(t *testing.T) {
+	client, err := NewClient("https://123-ABC-456.mktorest.com/rest", "id", "client-secret-value")
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.HTTPClient = &http.Client{Transport: failingTransport{}}
+
+	_, err = client.GetChannelByName(context.Background(), "Webinar")
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if strings.Contains(err.Error(), "client-secret-value") {
+		t.Errorf("error contains the secret: %s", err)
+	}
+}
@@ -0,0 +1,104 @@
+package marketo
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Credentials are the settings of a profile in a credentials file. Empty
+// fields are not set by the profile.
+type Credentials struct {
+	ID               string
+	Secret           string
+	Endpoint         string
+	MunchkinID       string
+	IdentityEndpoint string
+}
+
+// ErrProfileNotFound is returned when a credentials file exists but does not
+// have the requested profile.
+var ErrProfileNotFound = errors.New("profile not found")
+
+// DefaultCredentialsFile returns the path of ~/.marketo/credentials.
+func DefaultCredentialsFile() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".marketo", "credentials"), nil
+}
+
+// LoadCredentials reads a profile from an INI style credentials file, where
+// each profile is a section of key = value lines:
+//
+//	[sandbox]
+//	munchkin_id = 123-ABC-456
+//	id          = ...
+//	secret      = ...
+//
+// Lines starting with # or ; are comments. A missing file is returned as an
+// error that satisfies os.IsNotExist.
+func LoadCredentials(path string, profile string) (*Credentials, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var credentials *Credentials
+	section := ""
+	scanner := bufio.NewScanner(file)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") || strings.HasPrefix(text, ";") {
+			continue
+		}
+
+		if strings.HasPrefix(text, "[") && strings.HasSuffix(text, "]") {
+			section = strings.TrimSpace(text[1 : len(text)-1])
+			if section == profile && credentials == nil {
+				credentials = &Credentials{}
+			}
+			continue
+		}
+
+		if section != profile {
+			continue
+		}
+
+		parts := strings.SplitN(text, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("%s:%d: expected key = value", path, line)
+		}
+
+		key, value := strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1])
+		switch key {
+		case "id":
+			credentials.ID = value
+		case "secret":
+			credentials.Secret = value
+		case "endpoint":
+			credentials.Endpoint = value
+		case "munchkin_id":
+			credentials.MunchkinID = value
+		case "identity_endpoint":
+			credentials.IdentityEndpoint = value
+		default:
+			return nil, fmt.Errorf("%s:%d: unknown key %q", path, line, key)
+		}
+	}
+
+	err = scanner.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	if credentials == nil {
+		return nil, fmt.Errorf("%w: %q in %s", ErrProfileNotFound, profile, path)
+	}
+	return credentials, nil
+}
@@ -0,0 +1,91 @@
+package marketo
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeCredentials(t *testing.T, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "credentials")
+	err := os.WriteFile(path, []byte(content), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadCredentials(t *testing.T) {
+	path := writeCredentials(t, `
+# comment
+[default]
+munchkin_id = 123-ABC-456
+id          = default-id
+secret      = default-secret
+
+; another comment
+[sandbox]
+endpoint          = https://sandbox.mktorest.com/rest
+identity_endpoint = https://sandbox.mktorest.com/identity
+id                = sandbox-id
+secret            = a=b
+`)
+
+	credentials, err := LoadCredentials(path, "sandbox")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := Credentials{
+		ID:               "sandbox-id",
+		Secret:           "a=b",
+		Endpoint:         "https://sandbox.mktorest.com/rest",
+		IdentityEndpoint: "https://sandbox.mktorest.com/identity",
+	}
+	if *credentials != want {
+		t.Errorf("got %+v, want %+v", *credentials, want)
+	}
+}
+
+func TestLoadCredentialsErrors(t *testing.T) {
+	tests := map[string]struct {
+		content string
+		profile string
+		check   func(error) bool
+	}{
+		"missing profile": {
+			content: "[default]\nid = id\n",
+			profile: "sandbox",
+			check:   func(err error) bool { return errors.Is(err, ErrProfileNotFound) },
+		},
+		"unknown key": {
+			content: "[default]\nclient_id = id\n",
+			profile: "default",
+			check:   func(err error) bool { return err != nil && !errors.Is(err, ErrProfileNotFound) },
+		},
+		"missing value": {
+			content: "[default]\nid\n",
+			profile: "default",
+			check:   func(err error) bool { return err != nil && !errors.Is(err, ErrProfileNotFound) },
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := LoadCredentials(writeCredentials(t, test.content), test.profile)
+			if !test.check(err) {
+				t.Errorf("unexpected error %v", err)
+			}
+		})
+	}
+}
+
+func TestLoadCredentialsMissingFile(t *testing.T) {
+	_, err := LoadCredentials(filepath.Join(t.TempDir(), "credentials"), "default")
+	if !os.IsNotExist(err) {
+		t.Errorf("got error %v, want a missing file", err)
+	}
+}
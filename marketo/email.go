@@ -1,25 +1,236 @@
 package marketo
 
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
 type Email struct {
-	ID   string `json:"id"`
-	Name string `json:"name"`
+	ID          int         `json:"id"`
+	Name        string      `json:"name"`
+	Description string      `json:"description"`
+	Folder      FolderID    `json:"folder"`
+	Template    int         `json:"template"`
+	Subject     EmailHeader `json:"subject"`
+	FromName    EmailHeader `json:"fromName"`
+	FromEmail   EmailHeader `json:"fromEmail"`
+	ReplyEmail  EmailHeader `json:"replyEmail"`
+	Operational bool        `json:"operational"`
+	TextOnly    bool        `json:"textOnly"`
+	Status      string      `json:"status"`
+	Workspace   string      `json:"workspace"`
+	URL         string      `json:"url"`
+	CreatedAt   string      `json:"createdAt"`
+	UpdatedAt   string      `json:"updatedAt"`
+}
+
+// EmailHeader is a subject, sender or reply-to field of an email. Type is Text
+// for literal values or DynamicContent, in which case Value is the ID of the
+// segmentation.
+type EmailHeader struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
 }
 
-func (c *Client) CreateEmail(program Email) (*Email, error) {
-	var result Email
-	return &result, nil
+func (h EmailHeader) String() string {
+	kind := h.Type
+	if kind == "" {
+		kind = "Text"
+	}
+	return fmt.Sprintf(`{"type":%q,"value":%q}`, kind, h.Value)
 }
 
-func (c *Client) GetEmail(id string) (*Email, error) {
-	var result Email
-	return &result, nil
+// EmailSection is an editable section of an email, identified by the HTML ID
+// it has in the template. Type is one of Text, DynamicContent or Snippet and
+// Value holds the HTML, the segmentation ID or the snippet ID respectively.
+type EmailSection struct {
+	Section string
+	Type    string
+	Value   string
 }
 
-func (c *Client) UpdateEmail(id string, program Email) (*Email, error) {
-	var result Email
-	return &result, nil
+type emailContent struct {
+	HTMLID      string          `json:"htmlId"`
+	ContentType string          `json:"contentType"`
+	Value       json.RawMessage `json:"value"`
 }
 
-func (c *Client) DeleteEmail(id string) error {
-	return nil
+// sectionValue flattens the value of a section, which is a list of HTML and
+// text versions for Text sections and a plain ID otherwise.
+func sectionValue(raw json.RawMessage) string {
+	var versions []EmailHeader
+	if json.Unmarshal(raw, &versions) == nil {
+		for _, version := range versions {
+			if version.Type == "HTML" {
+				return version.Value
+			}
+		}
+		if len(versions) > 0 {
+			return versions[0].Value
+		}
+		return ""
+	}
+
+	var value string
+	if json.Unmarshal(raw, &value) == nil {
+		return value
+	}
+
+	var id int
+	if json.Unmarshal(raw, &id) == nil {
+		return strconv.Itoa(id)
+	}
+	return string(raw)
+}
+
+func (c *Client) CreateEmail(ctx context.Context, input Email) (*Email, error) {
+	form := url.Values{}
+	form.Set("name", input.Name)
+	form.Set("folder", input.Folder.String())
+	form.Set("template", strconv.Itoa(input.Template))
+	form.Set("description", input.Description)
+	form.Set("subject", input.Subject.Value)
+	form.Set("fromName", input.FromName.Value)
+	form.Set("fromEmail", input.FromEmail.Value)
+	form.Set("replyEmail", input.ReplyEmail.Value)
+	form.Set("operational", strconv.FormatBool(input.Operational))
+
+	var result []Email
+	err := c.post(ctx, "/asset/v1/emails.json", form, &result)
+	if err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, ErrNotFound
+	}
+	return &result[0], nil
+}
+
+func (c *Client) GetEmail(ctx context.Context, id string) (*Email, error) {
+	var result []Email
+	err := c.get(ctx, "/asset/v1/email/"+id+".json", nil, &result)
+	if err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, ErrNotFound
+	}
+	return &result[0], nil
+}
+
+// GetEmailByName finds an email by name, optionally only in the given folder
+// or program.
+func (c *Client) GetEmailByName(ctx context.Context, name string, folder *FolderID) (*Email, error) {
+	query := url.Values{}
+	query.Set("name", name)
+	if folder != nil {
+		query.Set("folder", folder.String())
+	}
+
+	var result []Email
+	err := c.get(ctx, "/asset/v1/email/byName.json", query, &result)
+	if err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, ErrNotFound
+	}
+	return &result[0], nil
+}
+
+// ListEmails pages through all emails that match the folder, status and
+// updated range of the filter.
+func (c *Client) ListEmails(ctx context.Context, filter ListFilter) ([]Email, error) {
+	var emails []Email
+
+	query := filter.query()
+	if filter.Folder != nil {
+		query.Set("folder", filter.Folder.String())
+	}
+	if filter.Status != "" {
+		query.Set("status", filter.Status)
+	}
+
+	query.Set("maxReturn", "200")
+	for offset := 0; ; offset += 200 {
+		query.Set("offset", strconv.Itoa(offset))
+
+		var result []Email
+		err := c.get(ctx, "/asset/v1/emails.json", query, &result)
+		if err != nil {
+			return nil, err
+		}
+
+		emails = append(emails, result...)
+		if len(result) < 200 {
+			return emails, nil
+		}
+	}
+}
+
+// UpdateEmail updates the metadata of an email. The headers are updated
+// separately through UpdateEmailHeaders.
+func (c *Client) UpdateEmail(ctx context.Context, id string, input Email) (*Email, error) {
+	form := url.Values{}
+	form.Set("name", input.Name)
+	form.Set("description", input.Description)
+	form.Set("operational", strconv.FormatBool(input.Operational))
+	form.Set("textOnly", strconv.FormatBool(input.TextOnly))
+
+	var result []Email
+	err := c.post(ctx, "/asset/v1/email/"+id+".json", form, &result)
+	if err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, ErrNotFound
+	}
+	return &result[0], nil
+}
+
+// UpdateEmailHeaders sets the subject, sender and reply-to address of an
+// email.
+func (c *Client) UpdateEmailHeaders(ctx context.Context, id string, input Email) error {
+	form := url.Values{}
+	form.Set("subject", input.Subject.String())
+	form.Set("fromName", input.FromName.String())
+	form.Set("fromEmail", input.FromEmail.String())
+	form.Set("replyTO", input.ReplyEmail.String())
+
+	return c.post(ctx, "/asset/v1/email/"+id+"/content.json", form, nil)
+}
+
+func (c *Client) DeleteEmail(ctx context.Context, id string) error {
+	return c.post(ctx, "/asset/v1/email/"+id+"/delete.json", url.Values{}, nil)
+}
+
+// GetEmailContent returns the editable sections of an email in the order of
+// the template.
+func (c *Client) GetEmailContent(ctx context.Context, id string) ([]EmailSection, error) {
+	var result []emailContent
+	err := c.get(ctx, "/asset/v1/email/"+id+"/content.json", nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	sections := make([]EmailSection, 0, len(result))
+	for _, content := range result {
+		sections = append(sections, EmailSection{
+			Section: content.HTMLID,
+			Type:    content.ContentType,
+			Value:   sectionValue(content.Value),
+		})
+	}
+	return sections, nil
+}
+
+func (c *Client) UpdateEmailSection(ctx context.Context, id string, section EmailSection) error {
+	form := url.Values{}
+	form.Set("type", section.Type)
+	form.Set("value", section.Value)
+
+	return c.post(ctx, "/asset/v1/email/"+id+"/content/"+url.PathEscape(section.Section)+".json", form, nil)
 }
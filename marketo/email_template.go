@@ -1,25 +1,149 @@
 package marketo
 
+import (
+	"context"
+	"net/url"
+	"strconv"
+)
+
 type EmailTemplate struct {
-	ID   string `json:"id"`
-	Name string `json:"name"`
+	ID          int      `json:"id"`
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Folder      FolderID `json:"folder"`
+	Status      string   `json:"status"`
+	Workspace   string   `json:"workspace"`
+	URL         string   `json:"url"`
+	CreatedAt   string   `json:"createdAt"`
+	UpdatedAt   string   `json:"updatedAt"`
+}
+
+type emailTemplateContent struct {
+	ID      int    `json:"id"`
+	Content string `json:"content"`
+}
+
+// CreateEmailTemplate uploads content as the HTML of a new template in the
+// folder of input.
+func (c *Client) CreateEmailTemplate(ctx context.Context, input EmailTemplate, content string) (*EmailTemplate, error) {
+	fields := url.Values{}
+	fields.Set("name", input.Name)
+	fields.Set("folder", input.Folder.String())
+	fields.Set("description", input.Description)
+
+	var result []EmailTemplate
+	err := c.postMultipart(ctx, "/asset/v1/emailTemplates.json", fields, input.Name+".html", []byte(content), &result)
+	if err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, ErrNotFound
+	}
+	return &result[0], nil
+}
+
+func (c *Client) GetEmailTemplate(ctx context.Context, id string) (*EmailTemplate, error) {
+	var result []EmailTemplate
+	err := c.get(ctx, "/asset/v1/emailTemplate/"+id+".json", nil, &result)
+	if err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, ErrNotFound
+	}
+	return &result[0], nil
 }
 
-func (c *Client) CreateEmailTemplate(program EmailTemplate) (*EmailTemplate, error) {
-	var result EmailTemplate
-	return &result, nil
+// GetEmailTemplateByName finds a template by name. The endpoint cannot be
+// scoped to a folder, so a template in another folder than the given one is
+// reported as not found.
+func (c *Client) GetEmailTemplateByName(ctx context.Context, name string, folder *FolderID) (*EmailTemplate, error) {
+	query := url.Values{}
+	query.Set("name", name)
+
+	var result []EmailTemplate
+	err := c.get(ctx, "/asset/v1/emailTemplate/byName.json", query, &result)
+	if err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, ErrNotFound
+	}
+	if folder != nil && result[0].Folder != *folder {
+		return nil, ErrNotFound
+	}
+	return &result[0], nil
+}
+
+// ListEmailTemplates pages through all templates that match the folder and
+// status of the filter. The endpoint cannot filter on folders, so that is
+// done here.
+func (c *Client) ListEmailTemplates(ctx context.Context, filter ListFilter) ([]EmailTemplate, error) {
+	var emailTemplates []EmailTemplate
+
+	query := url.Values{}
+	if filter.Status != "" {
+		query.Set("status", filter.Status)
+	}
+
+	query.Set("maxReturn", "200")
+	for offset := 0; ; offset += 200 {
+		query.Set("offset", strconv.Itoa(offset))
+
+		var result []EmailTemplate
+		err := c.get(ctx, "/asset/v1/emailTemplates.json", query, &result)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, emailTemplate := range result {
+			if filter.Folder != nil && emailTemplate.Folder != *filter.Folder {
+				continue
+			}
+			if !filter.updatedWithin(emailTemplate.UpdatedAt) {
+				continue
+			}
+			emailTemplates = append(emailTemplates, emailTemplate)
+		}
+
+		if len(result) < 200 {
+			return emailTemplates, nil
+		}
+	}
+}
+
+func (c *Client) UpdateEmailTemplate(ctx context.Context, id string, input EmailTemplate) (*EmailTemplate, error) {
+	form := url.Values{}
+	form.Set("name", input.Name)
+	form.Set("description", input.Description)
+
+	var result []EmailTemplate
+	err := c.post(ctx, "/asset/v1/emailTemplate/"+id+".json", form, &result)
+	if err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, ErrNotFound
+	}
+	return &result[0], nil
 }
 
-func (c *Client) GetEmailTemplate(id string) (*EmailTemplate, error) {
-	var result EmailTemplate
-	return &result, nil
+func (c *Client) DeleteEmailTemplate(ctx context.Context, id string) error {
+	return c.post(ctx, "/asset/v1/emailTemplate/"+id+"/delete.json", url.Values{}, nil)
 }
 
-func (c *Client) UpdateEmailTemplate(id string, program EmailTemplate) (*EmailTemplate, error) {
-	var result EmailTemplate
-	return &result, nil
+func (c *Client) GetEmailTemplateContent(ctx context.Context, id string) (string, error) {
+	var result []emailTemplateContent
+	err := c.get(ctx, "/asset/v1/emailTemplate/"+id+"/content.json", nil, &result)
+	if err != nil {
+		return "", err
+	}
+	if len(result) == 0 {
+		return "", ErrNotFound
+	}
+	return result[0].Content, nil
 }
 
-func (c *Client) DeleteEmailTemplate(id string) error {
-	return nil
+func (c *Client) UpdateEmailTemplateContent(ctx context.Context, id string, name string, content string) error {
+	return c.postMultipart(ctx, "/asset/v1/emailTemplate/"+id+"/content.json", url.Values{}, name+".html", []byte(content), nil)
 }
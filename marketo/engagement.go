@@ -0,0 +1,116 @@
+package marketo
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+)
+
+// Stream is a stream of an engagement program, which casts its content to
+// the leads in the stream on a cadence.
+type Stream struct {
+	ID      int     `json:"id"`
+	Name    string  `json:"name"`
+	Cadence Cadence `json:"cadence"`
+}
+
+// Cadence is when a stream casts. Recurrence is one of daily, weekly,
+// biweekly or monthly.
+type Cadence struct {
+	DayOfWeek  string `json:"dayOfWeek"`
+	Time       string `json:"time"`
+	Recurrence string `json:"recurrence"`
+}
+
+// StreamContent is an email or program in a stream. The order of the slice
+// passed to UpdateStreamContent is the order in which content is cast.
+type StreamContent struct {
+	Type   string `json:"type"`
+	ID     int    `json:"id"`
+	Active bool   `json:"active"`
+}
+
+func (c *Client) CreateStream(ctx context.Context, programID string, input Stream) (*Stream, error) {
+	cadence, err := json.Marshal(input.Cadence)
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{}
+	form.Set("name", input.Name)
+	form.Set("cadence", string(cadence))
+
+	var result []Stream
+	err = c.post(ctx, "/asset/v1/program/"+programID+"/streams.json", form, &result)
+	if err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, ErrNotFound
+	}
+	return &result[0], nil
+}
+
+func (c *Client) GetStream(ctx context.Context, programID string, id string) (*Stream, error) {
+	var result []Stream
+	err := c.get(ctx, "/asset/v1/program/"+programID+"/stream/"+id+".json", nil, &result)
+	if err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, ErrNotFound
+	}
+	return &result[0], nil
+}
+
+func (c *Client) UpdateStream(ctx context.Context, programID string, id string, input Stream) (*Stream, error) {
+	cadence, err := json.Marshal(input.Cadence)
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{}
+	form.Set("name", input.Name)
+	form.Set("cadence", string(cadence))
+
+	var result []Stream
+	err = c.post(ctx, "/asset/v1/program/"+programID+"/stream/"+id+".json", form, &result)
+	if err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, ErrNotFound
+	}
+	return &result[0], nil
+}
+
+func (c *Client) DeleteStream(ctx context.Context, programID string, id string) error {
+	return c.post(ctx, "/asset/v1/program/"+programID+"/stream/"+id+"/delete.json", url.Values{}, nil)
+}
+
+func (c *Client) GetStreamContent(ctx context.Context, programID string, id string) ([]StreamContent, error) {
+	var result []StreamContent
+	err := c.get(ctx, "/asset/v1/program/"+programID+"/stream/"+id+"/content.json", nil, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// UpdateStreamContent replaces the content of the stream with content, in
+// order.
+func (c *Client) UpdateStreamContent(ctx context.Context, programID string, id string, content []StreamContent) error {
+	if content == nil {
+		content = []StreamContent{}
+	}
+
+	payload, err := json.Marshal(content)
+	if err != nil {
+		return err
+	}
+
+	form := url.Values{}
+	form.Set("content", string(payload))
+
+	return c.post(ctx, "/asset/v1/program/"+programID+"/stream/"+id+"/content.json", form, nil)
+}
@@ -0,0 +1,65 @@
+package marketo
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+)
+
+type File struct {
+	ID          int      `json:"id"`
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Folder      FolderID `json:"folder"`
+	MimeType    string   `json:"mimeType"`
+	Size        int      `json:"size"`
+	URL         string   `json:"url"`
+	CreatedAt   string   `json:"createdAt"`
+	UpdatedAt   string   `json:"updatedAt"`
+}
+
+// CreateFile uploads content into the folder of input. With insertOnly set the
+// call fails when a file with the same name already exists instead of
+// replacing it.
+func (c *Client) CreateFile(ctx context.Context, input File, filename string, content []byte, insertOnly bool) (*File, error) {
+	fields := url.Values{}
+	fields.Set("name", input.Name)
+	fields.Set("folder", input.Folder.String())
+	fields.Set("description", input.Description)
+	fields.Set("insertOnly", strconv.FormatBool(insertOnly))
+
+	var result []File
+	err := c.postMultipart(ctx, "/asset/v1/files.json", fields, filename, content, &result)
+	if err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, ErrNotFound
+	}
+	return &result[0], nil
+}
+
+func (c *Client) GetFile(ctx context.Context, id string) (*File, error) {
+	var result []File
+	err := c.get(ctx, "/asset/v1/file/"+id+".json", nil, &result)
+	if err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, ErrNotFound
+	}
+	return &result[0], nil
+}
+
+// UpdateFileContent replaces the content of a file, keeping its ID and URL.
+func (c *Client) UpdateFileContent(ctx context.Context, id string, filename string, content []byte) (*File, error) {
+	var result []File
+	err := c.postMultipart(ctx, "/asset/v1/file/"+id+"/content.json", url.Values{}, filename, content, &result)
+	if err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, ErrNotFound
+	}
+	return &result[0], nil
+}
@@ -1,25 +1,246 @@
 package marketo
 
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// rootFolderName is the name of the root folder of programs in every
+// workspace.
+const rootFolderName = "Marketing Activities"
+
 type Folder struct {
-	ID   string `json:"id"`
-	Name string `json:"name"`
+	ID          int      `json:"id"`
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	FolderID    FolderID `json:"folderId"`
+	Parent      FolderID `json:"parent"`
+	Path        string   `json:"path"`
+	Type        string   `json:"type"`
+	IsSystem    bool     `json:"isSystem"`
+	Workspace   string   `json:"workspace"`
+	URL         string   `json:"url"`
+	CreatedAt   string   `json:"createdAt"`
+	UpdatedAt   string   `json:"updatedAt"`
 }
 
-func (c *Client) CreateFolder(program Folder) (*Folder, error) {
-	var result Folder
-	return &result, nil
+// CreateFolder creates a folder under input.Parent, or under the root folder
+// of the workspace of input, or of the client, when no parent is given.
+func (c *Client) CreateFolder(ctx context.Context, input Folder) (*Folder, error) {
+	parent, err := c.defaultParent(ctx, input.Parent, input.Workspace)
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{}
+	form.Set("name", input.Name)
+	form.Set("parent", parent.String())
+	form.Set("description", input.Description)
+
+	var result []Folder
+	err = c.post(ctx, "/asset/v1/folders.json", form, &result)
+	if err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, ErrNotFound
+	}
+	return &result[0], nil
 }
 
-func (c *Client) GetFolder(id string) (*Folder, error) {
-	var result Folder
-	return &result, nil
+func (c *Client) GetFolder(ctx context.Context, id string) (*Folder, error) {
+	query := url.Values{}
+	query.Set("type", "Folder")
+
+	var result []Folder
+	err := c.get(ctx, "/asset/v1/folder/"+id+".json", query, &result)
+	if err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, ErrNotFound
+	}
+	return &result[0], nil
 }
 
-func (c *Client) UpdateFolder(id string, program Folder) (*Folder, error) {
-	var result Folder
-	return &result, nil
+// GetFolderByName finds a folder by name, optionally only below root and in
+// the given workspace.
+func (c *Client) GetFolderByName(ctx context.Context, name string, root *FolderID, workspace string) (*Folder, error) {
+	folders, err := c.findFolders(ctx, name, root, workspace)
+	if err != nil {
+		return nil, err
+	}
+	if len(folders) == 0 {
+		return nil, ErrNotFound
+	}
+	return &folders[0], nil
 }
 
-func (c *Client) DeleteFolder(id string) error {
+// GetFolderByPath resolves a path such as "Marketing Activities/Events" one
+// segment at a time, starting at a root folder of the workspace. Programs
+// can be part of the path, in which case the result is of type Program.
+func (c *Client) GetFolderByPath(ctx context.Context, path string, workspace string) (*Folder, error) {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+
+	folder, err := c.GetFolderByName(ctx, segments[0], nil, c.workspace(workspace))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", segments[0], err)
+	}
+
+	for i, segment := range segments[1:] {
+		folders, err := c.findFolders(ctx, segment, &folder.FolderID, c.workspace(workspace))
+		if err != nil {
+			return nil, err
+		}
+
+		// The root parameter matches every descendant, so only direct
+		// children are considered.
+		var child *Folder
+		for j := range folders {
+			if folders[j].Parent.ID == folder.FolderID.ID && folders[j].Parent.Type == folder.FolderID.Type {
+				child = &folders[j]
+				break
+			}
+		}
+		if child == nil {
+			return nil, fmt.Errorf("%s: %w", strings.Join(segments[:i+2], "/"), ErrNotFound)
+		}
+		folder = child
+	}
+
+	return folder, nil
+}
+
+// ListFolders pages through all folders and programs below root, up to
+// maxDepth levels deep. The root itself is not included.
+func (c *Client) ListFolders(ctx context.Context, root FolderID, maxDepth int, workspace string) ([]Folder, error) {
+	var folders []Folder
+
+	query := url.Values{}
+	query.Set("root", root.String())
+	query.Set("maxDepth", strconv.Itoa(maxDepth))
+	if workspace != "" {
+		query.Set("workSpace", workspace)
+	}
+
+	query.Set("maxReturn", "200")
+	for offset := 0; ; offset += 200 {
+		query.Set("offset", strconv.Itoa(offset))
+
+		var result []Folder
+		err := c.get(ctx, "/asset/v1/folders.json", query, &result)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, folder := range result {
+			if folder.FolderID != root {
+				folders = append(folders, folder)
+			}
+		}
+		if len(result) < 200 {
+			return folders, nil
+		}
+	}
+}
+
+func (c *Client) findFolders(ctx context.Context, name string, root *FolderID, workspace string) ([]Folder, error) {
+	query := url.Values{}
+	query.Set("name", name)
+	if root != nil {
+		query.Set("root", root.String())
+	}
+	if workspace != "" {
+		query.Set("workSpace", workspace)
+	}
+
+	var result []Folder
+	err := c.get(ctx, "/asset/v1/folder/byName.json", query, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetWorkspaceRoot returns the Marketing Activities folder of a workspace.
+func (c *Client) GetWorkspaceRoot(ctx context.Context, workspace string) (*Folder, error) {
+	return c.GetFolderByName(ctx, rootFolderName, nil, workspace)
+}
+
+// defaultParent returns parent, or the root folder of the workspace when
+// parent is empty.
+func (c *Client) defaultParent(ctx context.Context, parent FolderID, workspace string) (FolderID, error) {
+	if parent.ID != 0 {
+		return parent, nil
+	}
+
+	root, err := c.GetWorkspaceRoot(ctx, c.workspace(workspace))
+	if err != nil {
+		return FolderID{}, err
+	}
+	return root.FolderID, nil
+}
+
+func (c *Client) UpdateFolder(ctx context.Context, id string, input Folder) (*Folder, error) {
+	form := url.Values{}
+	form.Set("name", input.Name)
+	form.Set("description", input.Description)
+	form.Set("type", "Folder")
+
+	var result []Folder
+	err := c.post(ctx, "/asset/v1/folder/"+id+".json", form, &result)
+	if err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, ErrNotFound
+	}
+	return &result[0], nil
+}
+
+func (c *Client) DeleteFolder(ctx context.Context, id string) error {
+	form := url.Values{}
+	form.Set("type", "Folder")
+
+	return c.post(ctx, "/asset/v1/folder/"+id+"/delete.json", form, nil)
+}
+
+// FolderID references the parent of an asset, which is either a folder or a
+// program.
+type FolderID struct {
+	ID   int    `json:"id"`
+	Type string `json:"type"`
+}
+
+// String encodes the reference in the JSON form the asset API expects in
+// form parameters.
+func (f FolderID) String() string {
+	return fmt.Sprintf(`{"id":%d,"type":"%s"}`, f.ID, f.Type)
+}
+
+// UnmarshalJSON accepts both shapes the API uses for parent references:
+// {"id": 1, "type": "Folder"} on most assets and {"value": 1, "type":
+// "Folder"} on programs and folders.
+func (f *FolderID) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		ID    int    `json:"id"`
+		Value int    `json:"value"`
+		Type  string `json:"type"`
+	}
+
+	err := json.Unmarshal(data, &raw)
+	if err != nil {
+		return err
+	}
+
+	f.ID = raw.ID
+	if f.ID == 0 {
+		f.ID = raw.Value
+	}
+	f.Type = raw.Type
 	return nil
 }
@@ -0,0 +1,60 @@
+package marketo
+
+import (
+	"net/url"
+	"time"
+)
+
+// ListFilter narrows down the assets returned by the List* methods. Empty
+// fields do not filter. Not every asset type supports every field.
+type ListFilter struct {
+	Folder   *FolderID
+	Status   string
+	TagType  string
+	TagValue string
+	Channel  string
+
+	// UpdatedAfter and UpdatedBefore bound the updatedAt of the assets and
+	// are given in RFC3339.
+	UpdatedAfter  string
+	UpdatedBefore string
+}
+
+// query sets the parameters that the list endpoints filter on themselves.
+func (f ListFilter) query() url.Values {
+	query := url.Values{}
+	if f.UpdatedAfter != "" {
+		query.Set("earliestUpdatedAt", f.UpdatedAfter)
+	}
+	if f.UpdatedBefore != "" {
+		query.Set("latestUpdatedAt", f.UpdatedBefore)
+	}
+	return query
+}
+
+// updatedWithin checks the updatedAt of an asset against the range of the
+// filter, for endpoints that cannot filter on it.
+func (f ListFilter) updatedWithin(updatedAt string) bool {
+	updated, err := ParseTime(updatedAt)
+	if err != nil {
+		return true
+	}
+
+	if after, err := time.Parse(time.RFC3339, f.UpdatedAfter); err == nil && updated.Before(after) {
+		return false
+	}
+	if before, err := time.Parse(time.RFC3339, f.UpdatedBefore); err == nil && updated.After(before) {
+		return false
+	}
+	return true
+}
+
+// ParseTime parses the timestamps of the asset API, which are mostly but not
+// always RFC3339.
+func ParseTime(value string) (time.Time, error) {
+	t, err := time.Parse(time.RFC3339, value)
+	if err == nil {
+		return t, nil
+	}
+	return time.Parse("2006-01-02T15:04:05Z-0700", value)
+}
@@ -0,0 +1,84 @@
+package marketo
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Requests are logged with tflog: a trace entry before each request and a
+// debug entry with its outcome. Outside of Terraform there is no logger in
+// the context and nothing is logged.
+
+// emailPattern matches email addresses, plain or query escaped, which lead
+// data and error messages can contain.
+var emailPattern = regexp.MustCompile(`[A-Za-z0-9._%+-]+(@|%40)[A-Za-z0-9.-]+\.[A-Za-z]{2,}`)
+
+// redactedParams are the query parameters whose values are never logged:
+// credentials and the values leads are looked up by.
+var redactedParams = map[string]bool{
+	"client_secret": true,
+	"access_token":  true,
+	"filterValues":  true,
+}
+
+// logContext returns ctx with the client secret, token and email addresses
+// masked in all log fields.
+func (c *Client) logContext(ctx context.Context, token string) context.Context {
+	for _, secret := range []string{c.Secret, token} {
+		if secret != "" {
+			ctx = tflog.MaskAllFieldValuesStrings(ctx, secret)
+		}
+	}
+	return tflog.MaskAllFieldValuesRegexes(ctx, emailPattern)
+}
+
+// requestFields are the log fields that identify req.
+func requestFields(req *http.Request, attempt int) map[string]interface{} {
+	query := req.URL.Query()
+	for key := range query {
+		if redactedParams[key] {
+			query[key] = []string{"redacted"}
+		}
+	}
+
+	return map[string]interface{}{
+		"method":  req.Method,
+		"path":    req.URL.Path,
+		"query":   query.Encode(),
+		"attempt": attempt,
+	}
+}
+
+// logResponse logs the outcome of a request that was sent at start. Either
+// resp or err is set, envelope is nil when the body was not decoded.
+func logResponse(ctx context.Context, fields map[string]interface{}, start time.Time, resp *http.Response, envelope *response, err error) {
+	fields["latency_ms"] = time.Since(start).Milliseconds()
+	if resp != nil {
+		fields["status"] = resp.StatusCode
+	}
+
+	if envelope != nil {
+		fields["request_id"] = envelope.RequestID
+		fields["success"] = envelope.Success
+
+		codes := make([]string, 0, len(envelope.Errors))
+		for _, e := range envelope.Errors {
+			codes = append(codes, e.Code)
+		}
+		if len(codes) > 0 {
+			fields["error_codes"] = strings.Join(codes, ",")
+		}
+	}
+
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.Debug(ctx, "Marketo API request failed", fields)
+		return
+	}
+	tflog.Debug(ctx, "Marketo API request", fields)
+}
@@ -0,0 +1,80 @@
+package marketo
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func TestLogsRedactCredentialsAndEmails(t *testing.T) {
+	client, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"requestId": "request", "success": false, "errors": [{"code": "1013", "message": "Lead jane.doe@example.com not found"}]}`)
+	})
+	client.Secret = "client-secret-value"
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	_, err := client.GetChannelByName(ctx, "jane.doe@example.com")
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+
+	logged := output.String()
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) == 0 {
+		t.Fatal("nothing was logged")
+	}
+
+	for _, secret := range []string{"client-secret-value", "token-1", "jane.doe", "jane.doe%40example.com"} {
+		if strings.Contains(logged, secret) {
+			t.Errorf("log contains %q:\n%s", secret, logged)
+		}
+	}
+
+	var reported bool
+	for _, entry := range entries {
+		if entry["@message"] == "Marketo API request" && entry["error_codes"] == "1013" {
+			reported = entry["success"] == false
+		}
+	}
+	if !reported {
+		t.Error("the error code of the request was not logged")
+	}
+}
+
+func TestRequestFieldsRedactParams(t *testing.T) {
+	query := url.Values{
+		"client_secret": {"secret"},
+		"access_token":  {"token"},
+		"filterValues":  {"jane@example.com"},
+		"batchSize":     {"300"},
+	}
+	req, err := http.NewRequest(http.MethodGet, "https://example.mktorest.com/rest/v1/leads.json?"+query.Encode(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := url.ParseQuery(requestFields(req, 1)["query"].(string))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, key := range []string{"client_secret", "access_token", "filterValues"} {
+		if got.Get(key) != "redacted" {
+			t.Errorf("got %s=%q, want it redacted", key, got.Get(key))
+		}
+	}
+	if got.Get("batchSize") != "300" {
+		t.Errorf("got batchSize=%q, want 300", got.Get("batchSize"))
+	}
+}
@@ -1,25 +1,365 @@
 package marketo
 
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+)
+
 type Program struct {
-	ID   string `json:"id"`
+	ID          int      `json:"id"`
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Type        string   `json:"type"`
+	Channel     string   `json:"channel"`
+	Folder      FolderID `json:"folder"`
+	Status      string   `json:"status"`
+	Workspace   string   `json:"workspace"`
+	Tags        []Tag    `json:"tags"`
+	URL         string   `json:"url"`
+	CreatedAt   string   `json:"createdAt"`
+	UpdatedAt   string   `json:"updatedAt"`
+
+	// Schedule of email and event programs.
+	StartDate string `json:"startDate,omitempty"`
+	EndDate   string `json:"endDate,omitempty"`
+
+	// Settings of email programs.
+	RecipientTimeZone bool    `json:"recipientTimeZone,omitempty"`
+	HeadStart         bool    `json:"headStart,omitempty"`
+	ABTest            *ABTest `json:"abTest,omitempty"`
+
+	// Webinar connector of programs of type Event with Webinar.
+	WebinarProvider string `json:"webinarProvider,omitempty"`
+	WebinarEventID  string `json:"webinarEventId,omitempty"`
+}
+
+// Approved reports whether an email program is approved, which locks it.
+func (p Program) Approved() bool {
+	return p.Status == "locked"
+}
+
+// ProgramAssets maps the names of the assets inside a program to their IDs.
+type ProgramAssets struct {
+	Emails         map[string]int
+	SmartCampaigns map[string]int
+	SmartLists     map[string]int
+	StaticLists    map[string]int
+	LandingPages   map[string]int
+}
+
+type asset struct {
+	ID   int    `json:"id"`
 	Name string `json:"name"`
 }
 
-func (c *Client) CreateProgram(input Program) (*Program, error) {
-	var result Program
-	return &result, nil
+// CreateProgram creates a program in input.Folder, or in the root folder of
+// the workspace of input, or of the client, when no folder is given.
+func (c *Client) CreateProgram(ctx context.Context, input Program) (*Program, error) {
+	folder, err := c.defaultParent(ctx, input.Folder, input.Workspace)
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{}
+	form.Set("name", input.Name)
+	form.Set("folder", folder.String())
+	form.Set("type", input.Type)
+	form.Set("channel", input.Channel)
+	form.Set("description", input.Description)
+
+	if len(input.Tags) > 0 {
+		tags, err := json.Marshal(input.Tags)
+		if err != nil {
+			return nil, err
+		}
+		form.Set("tags", string(tags))
+	}
+
+	var result []Program
+	err = c.post(ctx, "/asset/v1/programs.json", form, &result)
+	if err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, ErrNotFound
+	}
+	return &result[0], nil
+}
+
+func (c *Client) GetProgram(ctx context.Context, id string) (*Program, error) {
+	var result []Program
+	err := c.get(ctx, "/asset/v1/program/"+id+".json", nil, &result)
+	if err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, ErrNotFound
+	}
+	return &result[0], nil
 }
 
-func (c *Client) GetProgram(id string) (*Program, error) {
-	var result Program
-	return &result, nil
+// GetProgramByName finds a program by name, optionally only in the given
+// folder. Tags are included in the result.
+func (c *Client) GetProgramByName(ctx context.Context, name string, folder *FolderID) (*Program, error) {
+	query := url.Values{}
+	query.Set("name", name)
+	query.Set("includeTags", "true")
+
+	var result []Program
+	err := c.get(ctx, "/asset/v1/program/byName.json", query, &result)
+	if err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, ErrNotFound
+	}
+	if folder != nil && result[0].Folder != *folder {
+		return nil, ErrNotFound
+	}
+	return &result[0], nil
 }
 
-func (c *Client) UpdateProgram(id string, input Program) (*Program, error) {
-	var result Program
-	return &result, nil
+// ListPrograms pages through all programs that match the filter. Programs
+// with a tag are found through the tag endpoint, every other field is
+// matched here.
+func (c *Client) ListPrograms(ctx context.Context, filter ListFilter) ([]Program, error) {
+	var programs []Program
+
+	path := "/asset/v1/programs.json"
+	query := filter.query()
+	if filter.TagType != "" {
+		path = "/asset/v1/program/byTag.json"
+		query = url.Values{}
+		query.Set("tagType", filter.TagType)
+		query.Set("tagValue", filter.TagValue)
+	}
+
+	query.Set("maxReturn", "200")
+	for offset := 0; ; offset += 200 {
+		query.Set("offset", strconv.Itoa(offset))
+
+		var result []Program
+		err := c.get(ctx, path, query, &result)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, program := range result {
+			if filter.Folder != nil && program.Folder != *filter.Folder {
+				continue
+			}
+			if filter.Status != "" && program.Status != filter.Status {
+				continue
+			}
+			if filter.Channel != "" && program.Channel != filter.Channel {
+				continue
+			}
+			if !filter.updatedWithin(program.UpdatedAt) {
+				continue
+			}
+			programs = append(programs, program)
+		}
+
+		if len(result) < 200 {
+			return programs, nil
+		}
+	}
 }
 
-func (c *Client) DeleteProgram(id string) error {
-	return nil
+// UpdateProgram updates the name and description of a program. Its tags are
+// replaced when input.Tags is not nil, so an empty slice removes them all.
+func (c *Client) UpdateProgram(ctx context.Context, id string, input Program) (*Program, error) {
+	form := url.Values{}
+	form.Set("name", input.Name)
+	form.Set("description", input.Description)
+
+	if input.Tags != nil {
+		tags, err := json.Marshal(input.Tags)
+		if err != nil {
+			return nil, err
+		}
+		form.Set("tags", string(tags))
+	}
+
+	var result []Program
+	err := c.post(ctx, "/asset/v1/program/"+id+".json", form, &result)
+	if err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, ErrNotFound
+	}
+	return &result[0], nil
+}
+
+func (c *Client) DeleteProgram(ctx context.Context, id string) error {
+	return c.post(ctx, "/asset/v1/program/"+id+"/delete.json", url.Values{}, nil)
+}
+
+// CloneProgram copies the program with the given ID, including all of its
+// assets, into the folder of input, which defaults like it does for
+// CreateProgram.
+func (c *Client) CloneProgram(ctx context.Context, id string, input Program) (*Program, error) {
+	folder, err := c.defaultParent(ctx, input.Folder, input.Workspace)
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{}
+	form.Set("name", input.Name)
+	form.Set("folder", folder.String())
+	form.Set("description", input.Description)
+
+	var result []Program
+	err = c.post(ctx, "/asset/v1/program/"+id+"/clone.json", form, &result)
+	if err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, ErrNotFound
+	}
+	return &result[0], nil
+}
+
+// GetProgramAssets lists the emails, smart campaigns, lists and landing pages
+// that live in the program.
+func (c *Client) GetProgramAssets(ctx context.Context, id string) (*ProgramAssets, error) {
+	programID, err := strconv.Atoi(id)
+	if err != nil {
+		return nil, err
+	}
+	folder := FolderID{ID: programID, Type: "Program"}
+
+	var assets ProgramAssets
+	for path, target := range map[string]*map[string]int{
+		"/asset/v1/emails.json":         &assets.Emails,
+		"/asset/v1/smartCampaigns.json": &assets.SmartCampaigns,
+		"/asset/v1/smartLists.json":     &assets.SmartLists,
+		"/asset/v1/staticLists.json":    &assets.StaticLists,
+		"/asset/v1/landingPages.json":   &assets.LandingPages,
+	} {
+		result, err := c.listByFolder(ctx, path, folder)
+		if err != nil {
+			return nil, err
+		}
+
+		*target = map[string]int{}
+		for _, a := range result {
+			(*target)[a.Name] = a.ID
+		}
+	}
+
+	return &assets, nil
+}
+
+// listByFolder pages through an asset list endpoint filtered on a folder.
+func (c *Client) listByFolder(ctx context.Context, path string, folder FolderID) ([]asset, error) {
+	var assets []asset
+
+	query := url.Values{}
+	query.Set("folder", folder.String())
+	query.Set("maxReturn", "200")
+	for offset := 0; ; offset += 200 {
+		query.Set("offset", strconv.Itoa(offset))
+
+		var result []asset
+		err := c.get(ctx, path, query, &result)
+		if err != nil {
+			return nil, err
+		}
+
+		assets = append(assets, result...)
+		if len(result) < 200 {
+			return assets, nil
+		}
+	}
+}
+
+// EmailProgramSettings are the schedule and A/B test settings of a program of
+// type Email. They can only be changed while the program is unapproved.
+type EmailProgramSettings struct {
+	SendAt            string
+	RecipientTimeZone bool
+	HeadStart         bool
+	ABTest            *ABTest
+}
+
+type ABTest struct {
+	Type           string `json:"type"`
+	SampleSize     int    `json:"sampleSize"`
+	WinnerCriteria string `json:"winnerCriteria"`
+	WinnerSendAt   string `json:"winnerSendAt,omitempty"`
+}
+
+func (c *Client) UpdateEmailProgram(ctx context.Context, id string, settings EmailProgramSettings) error {
+	form := url.Values{}
+	form.Set("startDate", settings.SendAt)
+	form.Set("recipientTimeZone", strconv.FormatBool(settings.RecipientTimeZone))
+	form.Set("headStart", strconv.FormatBool(settings.HeadStart))
+	if settings.ABTest != nil {
+		abTest, err := json.Marshal(settings.ABTest)
+		if err != nil {
+			return err
+		}
+		form.Set("abTest", string(abTest))
+	}
+
+	return c.post(ctx, "/asset/v1/program/"+id+".json", form, nil)
+}
+
+// ApproveProgram approves an email program, which schedules its send. It
+// waits until the program is reported as approved.
+func (c *Client) ApproveProgram(ctx context.Context, id string) error {
+	err := c.post(ctx, "/asset/v1/program/"+id+"/approve.json", url.Values{}, nil)
+	if err != nil {
+		return err
+	}
+	return c.waitForProgram(ctx, id, true)
+}
+
+// UnapproveProgram unapproves an email program and waits until it is
+// reported as unapproved.
+func (c *Client) UnapproveProgram(ctx context.Context, id string) error {
+	err := c.post(ctx, "/asset/v1/program/"+id+"/unapprove.json", url.Values{}, nil)
+	if err != nil {
+		return err
+	}
+	return c.waitForProgram(ctx, id, false)
+}
+
+func (c *Client) waitForProgram(ctx context.Context, id string, approved bool) error {
+	return poll(ctx, "the approval of program "+id, func() (bool, error) {
+		program, err := c.GetProgram(ctx, id)
+		if err != nil {
+			return false, err
+		}
+		return program.Approved() == approved, nil
+	})
+}
+
+// EventSettings are the schedule and webinar connector of a program of type
+// Event or Event with Webinar.
+type EventSettings struct {
+	StartAt string
+	EndAt   string
+	Webinar *Webinar
+}
+
+type Webinar struct {
+	Provider string
+	EventID  string
+}
+
+func (c *Client) UpdateEventProgram(ctx context.Context, id string, settings EventSettings) error {
+	form := url.Values{}
+	form.Set("startDate", settings.StartAt)
+	form.Set("endDate", settings.EndAt)
+	if settings.Webinar != nil {
+		form.Set("webinarProvider", settings.Webinar.Provider)
+		form.Set("webinarEventId", settings.Webinar.EventID)
+	}
+
+	return c.post(ctx, "/asset/v1/program/"+id+".json", form, nil)
 }
@@ -0,0 +1,143 @@
+package marketo
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+)
+
+type Segmentation struct {
+	ID          int      `json:"id"`
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Folder      FolderID `json:"folder"`
+	Status      string   `json:"status"`
+	CreatedAt   string   `json:"createdAt"`
+	UpdatedAt   string   `json:"updatedAt"`
+}
+
+type Segment struct {
+	ID             int    `json:"id"`
+	Name           string `json:"name"`
+	SegmentationID int    `json:"segmentationId"`
+	Status         string `json:"status"`
+}
+
+func (c *Client) CreateSegmentation(ctx context.Context, input Segmentation) (*Segmentation, error) {
+	form := url.Values{}
+	form.Set("name", input.Name)
+	form.Set("folder", input.Folder.String())
+	form.Set("description", input.Description)
+
+	var result []Segmentation
+	err := c.post(ctx, "/asset/v1/segmentation.json", form, &result)
+	if err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, ErrNotFound
+	}
+	return &result[0], nil
+}
+
+// ListSegmentations returns all segmentations, in draft or approved state.
+func (c *Client) ListSegmentations(ctx context.Context) ([]Segmentation, error) {
+	var segmentations []Segmentation
+
+	query := url.Values{}
+	query.Set("maxReturn", "200")
+	for offset := 0; ; offset += 200 {
+		query.Set("offset", strconv.Itoa(offset))
+
+		var result []Segmentation
+		err := c.get(ctx, "/asset/v1/segmentation.json", query, &result)
+		if err != nil {
+			return nil, err
+		}
+
+		segmentations = append(segmentations, result...)
+		if len(result) < 200 {
+			return segmentations, nil
+		}
+	}
+}
+
+// GetSegmentation looks the segmentation up in ListSegmentations, the API has
+// no endpoint to get a single one.
+func (c *Client) GetSegmentation(ctx context.Context, id string) (*Segmentation, error) {
+	segmentations, err := c.ListSegmentations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, segmentation := range segmentations {
+		if strconv.Itoa(segmentation.ID) == id {
+			return &segmentation, nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+func (c *Client) GetSegmentationByName(ctx context.Context, name string) (*Segmentation, error) {
+	segmentations, err := c.ListSegmentations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, segmentation := range segmentations {
+		if segmentation.Name == name {
+			return &segmentation, nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+func (c *Client) UpdateSegmentation(ctx context.Context, id string, input Segmentation) (*Segmentation, error) {
+	form := url.Values{}
+	form.Set("name", input.Name)
+	form.Set("description", input.Description)
+
+	var result []Segmentation
+	err := c.post(ctx, "/asset/v1/segmentation/"+id+".json", form, &result)
+	if err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, ErrNotFound
+	}
+	return &result[0], nil
+}
+
+func (c *Client) DeleteSegmentation(ctx context.Context, id string) error {
+	return c.post(ctx, "/asset/v1/segmentation/"+id+"/delete.json", url.Values{}, nil)
+}
+
+// ApproveSegmentation approves a segmentation and waits until it is reported
+// as approved.
+func (c *Client) ApproveSegmentation(ctx context.Context, id string) error {
+	err := c.post(ctx, "/asset/v1/segmentation/"+id+"/approve.json", url.Values{}, nil)
+	if err != nil {
+		return err
+	}
+
+	return poll(ctx, "the approval of segmentation "+id, func() (bool, error) {
+		segmentation, err := c.GetSegmentation(ctx, id)
+		if err != nil {
+			return false, err
+		}
+		return segmentation.Status == "approved", nil
+	})
+}
+
+func (c *Client) UnapproveSegmentation(ctx context.Context, id string) error {
+	return c.post(ctx, "/asset/v1/segmentation/"+id+"/unapprove.json", url.Values{}, nil)
+}
+
+func (c *Client) GetSegments(ctx context.Context, id string) ([]Segment, error) {
+	var result []Segment
+	err := c.get(ctx, "/asset/v1/segmentation/"+id+"/segments.json", nil, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
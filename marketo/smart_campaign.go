@@ -1,25 +1,150 @@
 package marketo
 
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
 type SmartCampaign struct {
-	ID   string `json:"id"`
-	Name string `json:"name"`
+	ID            int      `json:"id"`
+	Name          string   `json:"name"`
+	Description   string   `json:"description"`
+	Type          string   `json:"type"`
+	Folder        FolderID `json:"folder"`
+	Status        string   `json:"status"`
+	IsActive      bool     `json:"isActive"`
+	IsRequestable bool     `json:"isRequestable"`
+	SmartListID   int      `json:"smartListId"`
+	FlowID        int      `json:"flowId"`
+	Workspace     string   `json:"workspace"`
+	URL           string   `json:"computedUrl"`
+	CreatedAt     string   `json:"createdAt"`
+	UpdatedAt     string   `json:"updatedAt"`
+}
+
+type campaignToken struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type scheduleInput struct {
+	Input struct {
+		RunAt  string          `json:"runAt"`
+		Tokens []campaignToken `json:"tokens,omitempty"`
+	} `json:"input"`
 }
 
-func (c *Client) CreateSmartCampaign(input SmartCampaign) (*SmartCampaign, error) {
-	var result SmartCampaign
-	return &result, nil
+func (c *Client) CreateSmartCampaign(ctx context.Context, input SmartCampaign) (*SmartCampaign, error) {
+	form := url.Values{}
+	form.Set("name", input.Name)
+	form.Set("folder", input.Folder.String())
+	form.Set("description", input.Description)
+
+	var result []SmartCampaign
+	err := c.post(ctx, "/asset/v1/smartCampaigns.json", form, &result)
+	if err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, ErrNotFound
+	}
+	return &result[0], nil
 }
 
-func (c *Client) GetSmartCampaign(id string) (*SmartCampaign, error) {
-	var result SmartCampaign
-	return &result, nil
+func (c *Client) GetSmartCampaign(ctx context.Context, id string) (*SmartCampaign, error) {
+	var result []SmartCampaign
+	err := c.get(ctx, "/asset/v1/smartCampaign/"+id+".json", nil, &result)
+	if err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, ErrNotFound
+	}
+	return &result[0], nil
 }
 
-func (c *Client) UpdateSmartCampaign(id string, input SmartCampaign) (*SmartCampaign, error) {
-	var result SmartCampaign
-	return &result, nil
+// GetSmartCampaignByName finds a smart campaign by name. The endpoint cannot
+// be scoped to a folder, so a campaign in another folder or program than the
+// given one is reported as not found.
+func (c *Client) GetSmartCampaignByName(ctx context.Context, name string, folder *FolderID) (*SmartCampaign, error) {
+	query := url.Values{}
+	query.Set("name", name)
+
+	var result []SmartCampaign
+	err := c.get(ctx, "/asset/v1/smartCampaign/byName.json", query, &result)
+	if err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, ErrNotFound
+	}
+	if folder != nil && result[0].Folder != *folder {
+		return nil, ErrNotFound
+	}
+	return &result[0], nil
 }
 
-func (c *Client) DeleteSmartCampaign(id string) error {
-	return nil
+// ListSmartCampaigns pages through all smart campaigns that match the
+// folder and updated range of the filter. Status is either active or
+// inactive.
+func (c *Client) ListSmartCampaigns(ctx context.Context, filter ListFilter) ([]SmartCampaign, error) {
+	var smartCampaigns []SmartCampaign
+
+	query := filter.query()
+	if filter.Folder != nil {
+		query.Set("folder", filter.Folder.String())
+	}
+	if filter.Status != "" {
+		query.Set("isActive", strconv.FormatBool(filter.Status == "active"))
+	}
+
+	query.Set("maxReturn", "200")
+	for offset := 0; ; offset += 200 {
+		query.Set("offset", strconv.Itoa(offset))
+
+		var result []SmartCampaign
+		err := c.get(ctx, "/asset/v1/smartCampaigns.json", query, &result)
+		if err != nil {
+			return nil, err
+		}
+
+		smartCampaigns = append(smartCampaigns, result...)
+		if len(result) < 200 {
+			return smartCampaigns, nil
+		}
+	}
+}
+
+func (c *Client) UpdateSmartCampaign(ctx context.Context, id string, input SmartCampaign) (*SmartCampaign, error) {
+	form := url.Values{}
+	form.Set("name", input.Name)
+	form.Set("description", input.Description)
+
+	var result []SmartCampaign
+	err := c.post(ctx, "/asset/v1/smartCampaign/"+id+".json", form, &result)
+	if err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, ErrNotFound
+	}
+	return &result[0], nil
+}
+
+func (c *Client) DeleteSmartCampaign(ctx context.Context, id string) error {
+	return c.post(ctx, "/asset/v1/smartCampaign/"+id+"/delete.json", url.Values{}, nil)
+}
+
+// ScheduleSmartCampaign schedules a batch campaign to run at runAt, overriding
+// the given program tokens for this run only.
+func (c *Client) ScheduleSmartCampaign(ctx context.Context, id string, runAt string, tokens map[string]string) error {
+	var input scheduleInput
+	input.Input.RunAt = runAt
+	for name, value := range tokens {
+		input.Input.Tokens = append(input.Input.Tokens, campaignToken{Name: name, Value: value})
+	}
+
+	return c.sendJSON(ctx, http.MethodPost, "/v1/campaigns/"+id+"/schedule.json", input, nil)
 }
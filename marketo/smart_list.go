@@ -1,25 +1,111 @@
 package marketo
 
+import (
+	"context"
+	"net/url"
+	"strconv"
+)
+
 type SmartList struct {
-	ID   string `json:"id"`
-	Name string `json:"name"`
+	ID          int      `json:"id"`
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Folder      FolderID `json:"folder"`
+	Workspace   string   `json:"workspace"`
+	URL         string   `json:"url"`
+	CreatedAt   string   `json:"createdAt"`
+	UpdatedAt   string   `json:"updatedAt"`
+}
+
+// CreateSmartList clones the smart list with ID source, as smart lists and
+// their rules cannot be created through the API.
+func (c *Client) CreateSmartList(ctx context.Context, source string, input SmartList) (*SmartList, error) {
+	form := url.Values{}
+	form.Set("name", input.Name)
+	form.Set("folder", input.Folder.String())
+	form.Set("description", input.Description)
+
+	var result []SmartList
+	err := c.post(ctx, "/asset/v1/smartList/"+source+"/clone.json", form, &result)
+	if err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, ErrNotFound
+	}
+	return &result[0], nil
+}
+
+func (c *Client) GetSmartList(ctx context.Context, id string) (*SmartList, error) {
+	var result []SmartList
+	err := c.get(ctx, "/asset/v1/smartList/"+id+".json", nil, &result)
+	if err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, ErrNotFound
+	}
+	return &result[0], nil
 }
 
-func (c *Client) CreateSmartList(program SmartList) (*SmartList, error) {
-	var result SmartList
-	return &result, nil
+func (c *Client) GetSmartListByName(ctx context.Context, name string) (*SmartList, error) {
+	query := url.Values{}
+	query.Set("name", name)
+
+	var result []SmartList
+	err := c.get(ctx, "/asset/v1/smartList/byName.json", query, &result)
+	if err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, ErrNotFound
+	}
+	return &result[0], nil
 }
 
-func (c *Client) GetSmartList(id string) (*SmartList, error) {
-	var result SmartList
-	return &result, nil
+// ListSmartLists pages through all smart lists that match the folder and
+// updated range of the filter.
+func (c *Client) ListSmartLists(ctx context.Context, filter ListFilter) ([]SmartList, error) {
+	var smartLists []SmartList
+
+	query := filter.query()
+	if filter.Folder != nil {
+		query.Set("folder", filter.Folder.String())
+	}
+
+	query.Set("maxReturn", "200")
+	for offset := 0; ; offset += 200 {
+		query.Set("offset", strconv.Itoa(offset))
+
+		var result []SmartList
+		err := c.get(ctx, "/asset/v1/smartLists.json", query, &result)
+		if err != nil {
+			return nil, err
+		}
+
+		smartLists = append(smartLists, result...)
+		if len(result) < 200 {
+			return smartLists, nil
+		}
+	}
 }
 
-func (c *Client) UpdateSmartList(id string, program SmartList) (*SmartList, error) {
-	var result SmartList
-	return &result, nil
+func (c *Client) UpdateSmartList(ctx context.Context, id string, input SmartList) (*SmartList, error) {
+	form := url.Values{}
+	form.Set("name", input.Name)
+	form.Set("description", input.Description)
+
+	var result []SmartList
+	err := c.post(ctx, "/asset/v1/smartList/"+id+".json", form, &result)
+	if err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, ErrNotFound
+	}
+	return &result[0], nil
 }
 
-func (c *Client) DeleteSmartList(id string) error {
-	return nil
+func (c *Client) DeleteSmartList(ctx context.Context, id string) error {
+	return c.post(ctx, "/asset/v1/smartList/"+id+"/delete.json", url.Values{}, nil)
 }
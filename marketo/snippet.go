@@ -0,0 +1,117 @@
+package marketo
+
+import (
+	"context"
+	"net/url"
+)
+
+type Snippet struct {
+	ID          int      `json:"id"`
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Folder      FolderID `json:"folder"`
+	Status      string   `json:"status"`
+	Workspace   string   `json:"workspace"`
+	URL         string   `json:"url"`
+	CreatedAt   string   `json:"createdAt"`
+	UpdatedAt   string   `json:"updatedAt"`
+}
+
+// SnippetContent is a single content section of a snippet. Type is one of
+// HTML, Text or DynamicContent, in which case Content holds the ID of the
+// segmentation.
+type SnippetContent struct {
+	Type    string `json:"type"`
+	Content string `json:"content"`
+}
+
+func (c *Client) CreateSnippet(ctx context.Context, input Snippet) (*Snippet, error) {
+	form := url.Values{}
+	form.Set("name", input.Name)
+	form.Set("folder", input.Folder.String())
+	form.Set("description", input.Description)
+
+	var result []Snippet
+	err := c.post(ctx, "/asset/v1/snippets.json", form, &result)
+	if err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, ErrNotFound
+	}
+	return &result[0], nil
+}
+
+func (c *Client) GetSnippet(ctx context.Context, id string) (*Snippet, error) {
+	var result []Snippet
+	err := c.get(ctx, "/asset/v1/snippet/"+id+".json", nil, &result)
+	if err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, ErrNotFound
+	}
+	return &result[0], nil
+}
+
+func (c *Client) UpdateSnippet(ctx context.Context, id string, input Snippet) (*Snippet, error) {
+	form := url.Values{}
+	form.Set("name", input.Name)
+	form.Set("description", input.Description)
+
+	var result []Snippet
+	err := c.post(ctx, "/asset/v1/snippet/"+id+".json", form, &result)
+	if err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, ErrNotFound
+	}
+	return &result[0], nil
+}
+
+func (c *Client) DeleteSnippet(ctx context.Context, id string) error {
+	return c.post(ctx, "/asset/v1/snippet/"+id+"/delete.json", url.Values{}, nil)
+}
+
+func (c *Client) GetSnippetContent(ctx context.Context, id string) ([]SnippetContent, error) {
+	var result []SnippetContent
+	err := c.get(ctx, "/asset/v1/snippet/"+id+"/content.json", nil, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (c *Client) UpdateSnippetContent(ctx context.Context, id string, content SnippetContent) error {
+	form := url.Values{}
+	form.Set("type", content.Type)
+	form.Set("content", content.Content)
+
+	return c.post(ctx, "/asset/v1/snippet/"+id+"/content.json", form, nil)
+}
+
+// ApproveSnippet approves the draft of a snippet and waits until the snippet
+// is reported as approved.
+func (c *Client) ApproveSnippet(ctx context.Context, id string) error {
+	err := c.post(ctx, "/asset/v1/snippet/"+id+"/approveDraft.json", url.Values{}, nil)
+	if err != nil {
+		return err
+	}
+
+	return poll(ctx, "the approval of snippet "+id, func() (bool, error) {
+		snippet, err := c.GetSnippet(ctx, id)
+		if err != nil {
+			return false, err
+		}
+		return snippet.Status == "approved", nil
+	})
+}
+
+func (c *Client) UnapproveSnippet(ctx context.Context, id string) error {
+	return c.post(ctx, "/asset/v1/snippet/"+id+"/unapprove.json", url.Values{}, nil)
+}
+
+func (c *Client) DiscardSnippetDraft(ctx context.Context, id string) error {
+	return c.post(ctx, "/asset/v1/snippet/"+id+"/discardDraft.json", url.Values{}, nil)
+}
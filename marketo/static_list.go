@@ -0,0 +1,159 @@
+package marketo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// listBatchSize is the maximum number of leads the list membership endpoints
+// accept or return per call.
+const listBatchSize = 300
+
+type StaticList struct {
+	ID          int      `json:"id"`
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Folder      FolderID `json:"folder"`
+	ComputedURL string   `json:"computedUrl"`
+	CreatedAt   string   `json:"createdAt"`
+	UpdatedAt   string   `json:"updatedAt"`
+}
+
+type leadID struct {
+	ID int `json:"id"`
+}
+
+type listMembership struct {
+	ID      int     `json:"id"`
+	Status  string  `json:"status"`
+	Reasons []Error `json:"reasons"`
+}
+
+func (c *Client) CreateStaticList(ctx context.Context, input StaticList) (*StaticList, error) {
+	form := url.Values{}
+	form.Set("name", input.Name)
+	form.Set("folder", input.Folder.String())
+	form.Set("description", input.Description)
+
+	var result []StaticList
+	err := c.post(ctx, "/asset/v1/staticLists.json", form, &result)
+	if err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, ErrNotFound
+	}
+	return &result[0], nil
+}
+
+func (c *Client) GetStaticList(ctx context.Context, id string) (*StaticList, error) {
+	var result []StaticList
+	err := c.get(ctx, "/asset/v1/staticList/"+id+".json", nil, &result)
+	if err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, ErrNotFound
+	}
+	return &result[0], nil
+}
+
+func (c *Client) UpdateStaticList(ctx context.Context, id string, input StaticList) (*StaticList, error) {
+	form := url.Values{}
+	form.Set("name", input.Name)
+	form.Set("description", input.Description)
+
+	var result []StaticList
+	err := c.post(ctx, "/asset/v1/staticList/"+id+".json", form, &result)
+	if err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, ErrNotFound
+	}
+	return &result[0], nil
+}
+
+func (c *Client) DeleteStaticList(ctx context.Context, id string) error {
+	return c.post(ctx, "/asset/v1/staticList/"+id+"/delete.json", url.Values{}, nil)
+}
+
+// GetListLeads pages through the members of a static list and returns their
+// lead IDs.
+func (c *Client) GetListLeads(ctx context.Context, listID string) ([]int, error) {
+	var leads []int
+
+	query := url.Values{}
+	query.Set("batchSize", strconv.Itoa(listBatchSize))
+	query.Set("fields", "id")
+	for {
+		var result []leadID
+		next, err := c.getPage(ctx, "/v1/lists/"+listID+"/leads.json", query, &result)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, lead := range result {
+			leads = append(leads, lead.ID)
+		}
+
+		if next == "" || len(result) == 0 {
+			return leads, nil
+		}
+		query.Set("nextPageToken", next)
+	}
+}
+
+// AddLeadsToList adds the leads to a static list in batches of 300.
+func (c *Client) AddLeadsToList(ctx context.Context, listID string, leads []int) error {
+	return c.changeListLeads(ctx, http.MethodPost, listID, leads)
+}
+
+// RemoveLeadsFromList removes the leads from a static list in batches of 300.
+// Leads that are not a member of the list are ignored.
+func (c *Client) RemoveLeadsFromList(ctx context.Context, listID string, leads []int) error {
+	return c.changeListLeads(ctx, http.MethodDelete, listID, leads)
+}
+
+func (c *Client) changeListLeads(ctx context.Context, method string, listID string, leads []int) error {
+	for start := 0; start < len(leads); start += listBatchSize {
+		end := start + listBatchSize
+		if end > len(leads) {
+			end = len(leads)
+		}
+
+		input := struct {
+			Input []leadID `json:"input"`
+		}{}
+		for _, id := range leads[start:end] {
+			input.Input = append(input.Input, leadID{ID: id})
+		}
+
+		var result []listMembership
+		err := c.sendJSON(ctx, method, "/v1/lists/"+listID+"/leads.json", input, &result)
+		if err != nil {
+			return err
+		}
+
+		for _, membership := range result {
+			if membership.Status != "skipped" {
+				continue
+			}
+
+			// 1015: lead not in list, which is what we wanted anyway.
+			if len(membership.Reasons) > 0 && membership.Reasons[0].Code == "1015" {
+				continue
+			}
+
+			if len(membership.Reasons) > 0 {
+				return fmt.Errorf("lead %d skipped: %s", membership.ID, membership.Reasons[0])
+			}
+			return fmt.Errorf("lead %d skipped", membership.ID)
+		}
+	}
+
+	return nil
+}
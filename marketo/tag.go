@@ -0,0 +1,65 @@
+package marketo
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// TagType is a program tag defined in Admin. ApplicableProgramTypes and
+// AllowableValues are comma separated.
+type TagType struct {
+	Name                   string `json:"tagType"`
+	ApplicableProgramTypes string `json:"applicableProgramTypes"`
+	Required               bool   `json:"required"`
+	AllowableValues        string `json:"allowableValues"`
+}
+
+// Tag is the value of a tag type on a program.
+type Tag struct {
+	Type  string `json:"tagType"`
+	Value string `json:"tagValue"`
+}
+
+// ProgramTypes returns the program types the tag type applies to.
+func (t TagType) ProgramTypes() []string {
+	return splitList(t.ApplicableProgramTypes)
+}
+
+// Values returns the values a program can use for the tag type.
+func (t TagType) Values() []string {
+	return splitList(t.AllowableValues)
+}
+
+func splitList(list string) []string {
+	var values []string
+	for _, value := range strings.Split(list, ",") {
+		value = strings.TrimSpace(value)
+		if value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+func (c *Client) ListTagTypes(ctx context.Context) ([]TagType, error) {
+	var tagTypes []TagType
+
+	query := url.Values{}
+	query.Set("maxReturn", "200")
+	for offset := 0; ; offset += 200 {
+		query.Set("offset", strconv.Itoa(offset))
+
+		var result []TagType
+		err := c.get(ctx, "/asset/v1/tagTypes.json", query, &result)
+		if err != nil {
+			return nil, err
+		}
+
+		tagTypes = append(tagTypes, result...)
+		if len(result) < 200 {
+			return tagTypes, nil
+		}
+	}
+}
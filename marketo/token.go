@@ -0,0 +1,57 @@
+package marketo
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+)
+
+// Token is a My Token defined on a program or folder. Type is one of date,
+// number, rich text, score, sfdc campaign or text.
+type Token struct {
+	Name  string `json:"name"`
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+type tokenList struct {
+	Folder FolderID `json:"folder"`
+	Tokens []Token  `json:"tokens"`
+}
+
+// GetTokens returns the tokens of the program or folder referenced by folder.
+func (c *Client) GetTokens(ctx context.Context, folder FolderID) ([]Token, error) {
+	query := url.Values{}
+	query.Set("folderType", folder.Type)
+
+	var result []tokenList
+	err := c.get(ctx, "/asset/v1/folder/"+strconv.Itoa(folder.ID)+"/tokens.json", query, &result)
+	if err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, nil
+	}
+	return result[0].Tokens, nil
+}
+
+// SetToken creates the token, or replaces the value of a token with the same
+// name.
+func (c *Client) SetToken(ctx context.Context, folder FolderID, token Token) error {
+	form := url.Values{}
+	form.Set("folderType", folder.Type)
+	form.Set("name", token.Name)
+	form.Set("type", token.Type)
+	form.Set("value", token.Value)
+
+	return c.post(ctx, "/asset/v1/folder/"+strconv.Itoa(folder.ID)+"/tokens.json", form, nil)
+}
+
+func (c *Client) DeleteToken(ctx context.Context, folder FolderID, token Token) error {
+	form := url.Values{}
+	form.Set("folderType", folder.Type)
+	form.Set("name", token.Name)
+	form.Set("type", token.Type)
+
+	return c.post(ctx, "/asset/v1/folder/"+strconv.Itoa(folder.ID)+"/tokens/delete.json", form, nil)
+}
@@ -0,0 +1,62 @@
+package marketo
+
+import (
+	"context"
+	"errors"
+	"net/url"
+)
+
+// probe is a cheap read that only succeeds when the role of the API user has
+// the named permission.
+type probe struct {
+	permission string
+	path       string
+	query      url.Values
+}
+
+// Write permissions cannot be probed without changing data, so only the read
+// permissions that the write permissions imply are checked.
+var probes = []probe{
+	{"Read-Only Assets", "/asset/v1/folders.json", url.Values{"maxReturn": {"1"}}},
+	{"Read-Only Lead", "/v1/lists.json", url.Values{"batchSize": {"1"}}},
+	{"Read-Only Campaign", "/v1/campaigns.json", url.Values{"batchSize": {"1"}}},
+}
+
+// Verification is the outcome of Verify.
+type Verification struct {
+	// User is the API-only user the credentials belong to, as reported by
+	// the identity endpoint.
+	User string
+
+	// Missing are the permissions the role of User lacks.
+	Missing []string
+}
+
+// Verify fetches a token and checks the permissions of the API user with a
+// probe per permission. Requests denied with 603 or 1003 are reported in
+// Missing, any other failure is returned as an error.
+func (c *Client) Verify(ctx context.Context) (*Verification, error) {
+	c.mu.Lock()
+	err := c.authenticate(ctx)
+	user := c.user
+	c.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	verification := &Verification{User: user}
+	for _, p := range probes {
+		err := c.get(ctx, p.path, p.query, nil)
+		if err == nil {
+			continue
+		}
+
+		var apiErr Error
+		if errors.As(err, &apiErr) && (apiErr.Code == "603" || apiErr.Code == "1003") {
+			verification.Missing = append(verification.Missing, p.permission)
+			continue
+		}
+		return nil, err
+	}
+	return verification, nil
+}